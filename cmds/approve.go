@@ -0,0 +1,93 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type ApproveCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender      AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Spender     AddressFlag        `arg:"" name:"spender" help:"spender address" required:"true"`
+	Amount      CurrencyAmountFlag `arg:"" name:"currency-amount" help:"allowance (ex: \"<currency>,<amount>\")" required:"true"`
+	FeeCurrency CurrencyIDFlag     `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag        `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	spender     base.Address
+	feePayer    base.Address
+}
+
+func (cmd *ApproveCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ApproveCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	a, err = cmd.Spender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid spender format, %v", cmd.Spender.String())
+	}
+	cmd.spender = a
+
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
+	return nil
+}
+
+func (cmd *ApproveCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	am := types.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewApproveFact([]byte(cmd.Token), cmd.sender, cmd.spender, am).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
+
+	op, err := currency.NewApprove(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create approve operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create approve operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,96 @@
+package cmds
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/batch"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type BatchCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender     AddressFlag `arg:"" name:"sender" help:"sender address" required:"true"`
+	Operations []string    `arg:"" name:"operation" help:"json file of operation; the fact of operation is added to batch" required:"true"`
+	sender     base.Address
+	items      []base.Fact
+}
+
+func (cmd *BatchCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *BatchCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	if len(cmd.Operations) < 1 {
+		return errors.Errorf("empty operations")
+	}
+
+	items := make([]base.Fact, len(cmd.Operations))
+	for i := range cmd.Operations {
+		b, err := os.ReadFile(filepath.Clean(cmd.Operations[i]))
+		if err != nil {
+			return errors.Wrapf(err, "failed to read operation, %q", cmd.Operations[i])
+		}
+
+		hinter, err := enc.Decode(b)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode operation, %q", cmd.Operations[i])
+		}
+
+		op, ok := hinter.(base.Operation)
+		if !ok {
+			return errors.Errorf("expected Operation, not %T, %q", hinter, cmd.Operations[i])
+		}
+
+		items[i] = op.Fact()
+	}
+	cmd.items = items
+
+	return nil
+}
+
+func (cmd *BatchCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := batch.NewBatchFact([]byte(cmd.Token), cmd.sender, cmd.items)
+
+	op, err := batch.NewBatch(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create batch operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create batch operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,70 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type CancelStandingOrderCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender AddressFlag `arg:"" name:"sender" help:"sender address" required:"true"`
+	ID     string      `arg:"" name:"standing-order-id" help:"standing order id" required:"true"`
+	sender base.Address
+}
+
+func (cmd *CancelStandingOrderCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CancelStandingOrderCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return nil
+}
+
+func (cmd *CancelStandingOrderCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewCancelStandingOrderFact(
+		[]byte(cmd.Token), cmd.sender, types.StandingOrderID(cmd.ID))
+
+	op, err := currency.NewCancelStandingOrder(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cancel-standing-order operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cancel-standing-order operation")
+	}
+
+	return op, nil
+}
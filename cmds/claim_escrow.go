@@ -0,0 +1,91 @@
+package cmds
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/escrow"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type ClaimEscrowCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender      AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	ID          string         `arg:"" name:"escrow-id" help:"escrow id" required:"true"`
+	Preimage    string         `arg:"" name:"preimage" help:"hex encoded preimage of hashlock" required:"true"`
+	FeeCurrency CurrencyIDFlag `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag    `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	preimage    []byte
+	feePayer    base.Address
+}
+
+func (cmd *ClaimEscrowCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ClaimEscrowCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	preimage, err := hex.DecodeString(cmd.Preimage)
+	if err != nil {
+		return errors.Wrapf(err, "invalid preimage format, %v", cmd.Preimage)
+	}
+	cmd.preimage = preimage
+
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
+	return nil
+}
+
+func (cmd *ClaimEscrowCommand) createOperation() (base.Operation, error) {
+	fact := escrow.NewClaimEscrowFact(
+		[]byte(cmd.Token), cmd.sender, types.EscrowID(cmd.ID), cmd.preimage,
+	).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
+
+	op, err := escrow.NewClaimEscrow(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create claim-escrow operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create claim-escrow operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,89 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type ClaimVestingCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender      AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Grantor     AddressFlag    `arg:"" name:"grantor" help:"address which created the vesting" required:"true"`
+	Currency    CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	FeeCurrency CurrencyIDFlag `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag    `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	grantor     base.Address
+	feePayer    base.Address
+}
+
+func (cmd *ClaimVestingCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ClaimVestingCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	a, err = cmd.Grantor.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid grantor format, %v", cmd.Grantor.String())
+	}
+	cmd.grantor = a
+
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
+	return nil
+}
+
+func (cmd *ClaimVestingCommand) createOperation() (base.Operation, error) {
+	fact := currency.NewClaimVestingFact(
+		[]byte(cmd.Token), cmd.sender, cmd.grantor, cmd.Currency.CID,
+	).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
+
+	op, err := currency.NewClaimVesting(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create claim-vesting operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create claim-vesting operation")
+	}
+
+	return op, nil
+}
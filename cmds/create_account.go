@@ -18,8 +18,12 @@ type CreateAccountCommand struct {
 	Keys        []KeyFlag            `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
 	Amounts     []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
 	AddressType string               `help:"address type for new account select mitum or ether" default:"mitum"`
+	Memo        string               `name:"memo" help:"memo"`
+	FeeCurrency CurrencyIDFlag       `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag          `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
 	sender      base.Address
 	keys        types.AccountKeys
+	feePayer    base.Address
 }
 
 func (cmd *CreateAccountCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -88,6 +92,14 @@ func (cmd *CreateAccountCommand) parseFlags() error {
 		}
 	}
 
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
 	return nil
 }
 
@@ -111,13 +123,13 @@ func (cmd *CreateAccountCommand) createOperation() (base.Operation, error) { //
 		addrType = types.EthAddressHint.Type()
 	}
 
-	item := currency.NewCreateAccountItemMultiAmounts(cmd.keys, ams, addrType)
+	item := currency.NewCreateAccountItemMultiAmounts(cmd.keys, ams, addrType).SetMemo(cmd.Memo)
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
 	}
 	items = append(items, item)
 
-	fact := currency.NewCreateAccountFact([]byte(cmd.Token), cmd.sender, items)
+	fact := currency.NewCreateAccountFact([]byte(cmd.Token), cmd.sender, items).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
 
 	op, err := currency.NewCreateAccount(fact)
 	if err != nil {
@@ -17,8 +17,11 @@ type CreateContractAccountCommand struct {
 	Keys        []KeyFlag            `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
 	Amounts     []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
 	AddressType string               `help:"address type for new account select mitum or ether" default:"mitum"`
+	FeeCurrency CurrencyIDFlag       `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag          `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
 	sender      base.Address
 	keys        types.AccountKeys
+	feePayer    base.Address
 }
 
 func (cmd *CreateContractAccountCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -87,6 +90,14 @@ func (cmd *CreateContractAccountCommand) parseFlags() error {
 		}
 	}
 
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
 	return nil
 }
 
@@ -116,7 +127,7 @@ func (cmd *CreateContractAccountCommand) createOperation() (base.Operation, erro
 	}
 	items = append(items, item)
 
-	fact := extension.NewCreateContractAccountFact([]byte(cmd.Token), cmd.sender, items)
+	fact := extension.NewCreateContractAccountFact([]byte(cmd.Token), cmd.sender, items).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
 
 	op, err := extension.NewCreateContractAccount(fact)
 	if err != nil {
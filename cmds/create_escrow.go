@@ -0,0 +1,107 @@
+package cmds
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/escrow"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type CreateEscrowCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender      AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver    AddressFlag        `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	ID          string             `arg:"" name:"escrow-id" help:"escrow id" required:"true"`
+	Amount      CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")" required:"true"`
+	Hashlock    string             `arg:"" name:"hashlock" help:"hex encoded sha256 hash of preimage" required:"true"`
+	Timeout     uint64             `arg:"" name:"timeout" help:"height until which receiver can claim" required:"true"`
+	FeeCurrency CurrencyIDFlag     `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag        `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	receiver    base.Address
+	hashlock    []byte
+	feePayer    base.Address
+}
+
+func (cmd *CreateEscrowCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CreateEscrowCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	a, err = cmd.Receiver.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %v", cmd.Receiver.String())
+	}
+	cmd.receiver = a
+
+	hashlock, err := hex.DecodeString(cmd.Hashlock)
+	if err != nil {
+		return errors.Wrapf(err, "invalid hashlock format, %v", cmd.Hashlock)
+	}
+	cmd.hashlock = hashlock
+
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
+	return nil
+}
+
+func (cmd *CreateEscrowCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	am := types.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := escrow.NewCreateEscrowFact(
+		[]byte(cmd.Token), cmd.sender, cmd.receiver, types.EscrowID(cmd.ID), am,
+		cmd.hashlock, base.Height(cmd.Timeout),
+	).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
+
+	op, err := escrow.NewCreateEscrow(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create create-escrow operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create create-escrow operation")
+	}
+
+	return op, nil
+}
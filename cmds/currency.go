@@ -1,11 +1,26 @@
 package cmds
 
 type CurrencyCommand struct {
-	CreateAccount         CreateAccountCommand         `cmd:"" name:"create-account" help:"create new account"`
-	UpdateKey             UpdateKeyCommand             `cmd:"" name:"update-key" help:"update account keys"`
-	Transfer              TransferCommand              `cmd:"" name:"transfer" help:"transfer"`
-	RegisterCurrency      RegisterCurrencyCommand      `cmd:"" name:"register-currency" help:"register new currency"`
-	UpdateCurrency        UpdateCurrencyCommand        `cmd:"" name:"update-currency" help:"update currency policy"`
-	CreateContractAccount CreateContractAccountCommand `cmd:"" name:"create-contract-account" help:"create new contract account"`
-	Withdraw              WithdrawCommand              `cmd:"" name:"withdraw" help:"withdraw amounts from target contract account"`
+	CreateAccount              CreateAccountCommand              `cmd:"" name:"create-account" help:"create new account"`
+	UpdateKey                  UpdateKeyCommand                  `cmd:"" name:"update-key" help:"update account keys"`
+	Transfer                   TransferCommand                   `cmd:"" name:"transfer" help:"transfer"`
+	Burn                       BurnCommand                       `cmd:"" name:"burn" help:"burn amount of sender"`
+	CreateVesting              CreateVestingCommand              `cmd:"" name:"create-vesting" help:"lock amount of sender for receiver with release schedule"`
+	ClaimVesting               ClaimVestingCommand               `cmd:"" name:"claim-vesting" help:"claim vested amount"`
+	Approve                    ApproveCommand                    `cmd:"" name:"approve" help:"approve spender to transfer amount of sender"`
+	TransferFrom               TransferFromCommand               `cmd:"" name:"transfer-from" help:"transfer amount of owner within allowance"`
+	RegisterStandingOrder      RegisterStandingOrderCommand      `cmd:"" name:"register-standing-order" help:"register recurring transfer of sender"`
+	CancelStandingOrder        CancelStandingOrderCommand        `cmd:"" name:"cancel-standing-order" help:"cancel standing order of sender"`
+	Batch                      BatchCommand                      `cmd:"" name:"batch" help:"process operations of sender at once"`
+	RegisterCurrency           RegisterCurrencyCommand           `cmd:"" name:"register-currency" help:"register new currency"`
+	UpdateCurrency             UpdateCurrencyCommand             `cmd:"" name:"update-currency" help:"update currency policy"`
+	UpdateFeeRate              UpdateFeeRateCommand              `cmd:"" name:"update-fee-rate" help:"update fee rate to pay fee in other currency"`
+	Freeze                     FreezeCommand                     `cmd:"" name:"freeze" help:"freeze or unfreeze balance of account"`
+	CreateContractAccount      CreateContractAccountCommand      `cmd:"" name:"create-contract-account" help:"create new contract account"`
+	Withdraw                   WithdrawCommand                   `cmd:"" name:"withdraw" help:"withdraw amounts from target contract account"`
+	UpdateOperator             UpdateOperatorCommand             `cmd:"" name:"update-operator" help:"update operators of contract account"`
+	UpdateContractAccountOwner UpdateContractAccountOwnerCommand `cmd:"" name:"update-contract-account-owner" help:"update owner of contract account"`
+	CreateEscrow               CreateEscrowCommand               `cmd:"" name:"create-escrow" help:"lock amount of sender for receiver with hashlock and timeout"`
+	ClaimEscrow                ClaimEscrowCommand                `cmd:"" name:"claim-escrow" help:"claim escrowed amount with preimage of hashlock"`
+	RefundEscrow               RefundEscrowCommand               `cmd:"" name:"refund-escrow" help:"refund escrowed amount after timeout"`
 }
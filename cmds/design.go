@@ -3,8 +3,10 @@ package cmds
 import (
 	"context"
 	"net/url"
+	"strings"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/digest"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
 
 	vault "github.com/hashicorp/vault/api"
@@ -23,6 +25,7 @@ var (
 	DefaultDigestAPICache *url.URL
 	DefaultDigestAPIBind  string
 	DefaultDigestAPIURL   string
+	DefaultWebhookRetries = 5
 )
 
 func init() {
@@ -112,9 +115,11 @@ type CurrencyDesign struct {
 	CurrencyString             *string      `yaml:"currency"`
 	BalanceString              *string      `yaml:"balance"`
 	NewAccountMinBalanceString *string      `yaml:"new-account-min-balance"`
+	MaxSupplyString            *string      `yaml:"max-supply"`
 	Feeer                      *FeeerDesign `yaml:"feeer"`
 	Balance                    types.Amount `yaml:"-"`
 	NewAccountMinBalance       common.Big   `yaml:"-"`
+	MaxSupply                  common.Big   `yaml:"-"`
 }
 
 func (de *CurrencyDesign) IsValid([]byte) error {
@@ -148,6 +153,20 @@ func (de *CurrencyDesign) IsValid([]byte) error {
 		de.NewAccountMinBalance = b
 	}
 
+	if de.MaxSupplyString == nil {
+		de.MaxSupply = common.ZeroBig
+	} else {
+		b, err := common.NewBigFromString(*de.MaxSupplyString)
+		if err != nil {
+			return mitumutil.ErrInvalid.Wrap(err)
+		}
+		de.MaxSupply = b
+
+		if de.Balance.Big().OverZero() && de.Balance.Big().Compare(b) > 0 {
+			return errors.Errorf("balance over max-supply, %v > %v", de.Balance.Big(), b)
+		}
+	}
+
 	if de.Feeer == nil {
 		de.Feeer = &FeeerDesign{}
 	} else if err := de.Feeer.IsValid(nil); err != nil {
@@ -174,6 +193,10 @@ func (no *FeeerDesign) IsValid([]byte) error {
 		if err := no.checkRatio(no.Extras); err != nil {
 			return err
 		}
+	case types.FeeerTiered:
+		if err := no.checkTiered(no.Extras); err != nil {
+			return err
+		}
 	default:
 		return errors.Errorf("unknown type of feeer, %v", t)
 	}
@@ -223,13 +246,270 @@ func (no FeeerDesign) checkRatio(c map[string]interface{}) error {
 	return nil
 }
 
+func (no FeeerDesign) checkTiered(c map[string]interface{}) error {
+	a, found := c["tiers"]
+	if !found {
+		return errors.Errorf("tiered needs `tiers`")
+	}
+
+	l, ok := a.([]interface{})
+	if !ok {
+		return errors.Errorf("invalid tiers value type, %T of tiered; should be list", a)
+	}
+
+	tiers := make([]types.FeeTier, len(l))
+	for i := range l {
+		m, ok := l[i].(map[string]interface{})
+		if !ok {
+			return errors.Errorf("invalid tier value type, %T of tiered", l[i])
+		}
+
+		from, err := common.NewBigFromInterface(m["from"])
+		if err != nil {
+			return errors.Wrapf(err, "invalid from value, %v of tiered", m["from"])
+		}
+
+		fixed := common.ZeroBig
+		if v, found := m["fixed"]; found {
+			if fixed, err = common.NewBigFromInterface(v); err != nil {
+				return errors.Wrapf(err, "invalid fixed value, %v of tiered", v)
+			}
+		}
+
+		var ratio float64
+		switch t := m["ratio"].(type) {
+		case nil:
+		case float64:
+			ratio = t
+		case int:
+			ratio = float64(t)
+		default:
+			return errors.Errorf("invalid ratio value type, %T of tiered; should be float64", t)
+		}
+
+		max := types.UnlimitedMaxFeeAmount
+		if v, found := m["max"]; found {
+			if max, err = common.NewBigFromInterface(v); err != nil {
+				return errors.Wrapf(err, "invalid max value, %v of tiered", v)
+			}
+		}
+
+		tiers[i] = types.NewFeeTier(from, fixed, ratio, max)
+		if err := tiers[i].IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	no.Extras["tiered_tiers"] = tiers
+
+	return nil
+}
+
+// WebhookDesign enables the webhook; AdminToken is the bearer token of the
+// webhook admin endpoints.
+type WebhookDesign struct {
+	AdminToken string `yaml:"admin-token"`
+	Retries    *int   `yaml:"retries,omitempty"`
+}
+
+func (de *WebhookDesign) IsValid([]byte) error {
+	if len(de.AdminToken) < 16 {
+		return errors.Errorf("too short webhook admin-token; at least 16 characters")
+	}
+
+	if de.Retries == nil {
+		r := DefaultWebhookRetries
+		de.Retries = &r
+	} else if *de.Retries < 0 {
+		return errors.Errorf("negative webhook retries, %d", *de.Retries)
+	}
+
+	return nil
+}
+
+// RateLimitDesign limits the requests of digest API by route prefix and
+// client. Rules and the rules of Clients are "<limit>/<period>", like "10/1s",
+// or "unlimited"; the keys of Clients are IP addresses, the names of API keys
+// in AuthDesign or, without AuthDesign, API keys. TrustedProxies is the number of proxies, which
+// append to ClientIPHeader, in front of the API nodes; the default is 1.
+type RateLimitDesign struct {
+	Store          string                       `yaml:"store,omitempty"`
+	ClientIPHeader string                       `yaml:"client-ip-header,omitempty"`
+	TrustedProxies int                          `yaml:"trusted-proxies,omitempty"`
+	Rules          map[string]string            `yaml:"rules"`
+	Clients        map[string]map[string]string `yaml:"clients,omitempty"`
+	rules          map[string]digest.RateLimitRule
+	clients        map[string]map[string]digest.RateLimitRule
+}
+
+func (de *RateLimitDesign) IsValid([]byte) error {
+	if len(de.Store) < 1 {
+		de.Store = digest.DefaultRateLimitStore
+	}
+
+	switch u, err := url.Parse(de.Store); {
+	case err != nil:
+		return errors.Wrap(err, "invalid rate limit store")
+	case u.Scheme != "memory" && u.Scheme != "redis" && u.Scheme != "rediss":
+		return errors.Errorf("unsupported rate limit store, %q", de.Store)
+	}
+
+	if de.TrustedProxies < 0 {
+		return errors.Errorf("negative trusted proxies, %d", de.TrustedProxies)
+	}
+
+	rules, err := parseRateLimitRules(de.Rules)
+	if err != nil {
+		return err
+	}
+
+	clients := map[string]map[string]digest.RateLimitRule{}
+
+	for k := range de.Clients {
+		i, err := parseRateLimitRules(de.Clients[k])
+		if err != nil {
+			return errors.WithMessagef(err, "client, %q", k)
+		}
+
+		clients[k] = i
+	}
+
+	de.rules = rules
+	de.clients = clients
+
+	return nil
+}
+
+func (de *RateLimitDesign) RateLimitRules() map[string]digest.RateLimitRule {
+	return de.rules
+}
+
+func (de *RateLimitDesign) ClientRateLimitRules() map[string]map[string]digest.RateLimitRule {
+	return de.clients
+}
+
+func parseRateLimitRules(m map[string]string) (map[string]digest.RateLimitRule, error) {
+	rules := map[string]digest.RateLimitRule{}
+
+	for prefix := range m {
+		if !strings.HasPrefix(prefix, "/") {
+			return nil, errors.Errorf("rate limit prefix should start with /, %q", prefix)
+		}
+
+		rule, err := digest.ParseRateLimitRule(m[prefix])
+		if err != nil {
+			return nil, err
+		}
+
+		rules[prefix] = rule
+	}
+
+	return rules, nil
+}
+
+// AuthDesign enables the API key authentication of digest API. Public is the
+// scopes of the request without key; the scopes are "read", "send" and
+// "admin".
+type AuthDesign struct {
+	Public []string       `yaml:"public,omitempty"`
+	Keys   []APIKeyDesign `yaml:"keys,omitempty"`
+}
+
+type APIKeyDesign struct {
+	Name   string   `yaml:"name"`
+	Key    string   `yaml:"key"`
+	Scopes []string `yaml:"scopes"`
+}
+
+func (de *AuthDesign) IsValid([]byte) error {
+	public, err := parseAPIKeyScopes(de.Public)
+	if err != nil {
+		return err
+	}
+
+	for i := range public {
+		if public[i] == digest.APIKeyScopeAdmin {
+			return errors.Errorf("admin scope can not be public")
+		}
+	}
+
+	names := map[string]struct{}{}
+	keys := map[string]struct{}{}
+
+	for i := range de.Keys {
+		k := de.Keys[i]
+
+		switch {
+		case len(k.Name) < 1:
+			return errors.Errorf("empty api key name")
+		case len(k.Key) < 16:
+			return errors.Errorf("too short api key, %q; at least 16 characters", k.Name)
+		}
+
+		if _, found := names[k.Name]; found {
+			return errors.Errorf("duplicated api key name, %q", k.Name)
+		}
+
+		if _, found := keys[k.Key]; found {
+			return errors.Errorf("duplicated api key, %q", k.Name)
+		}
+
+		if _, err := parseAPIKeyScopes(k.Scopes); err != nil {
+			return errors.WithMessagef(err, "api key, %q", k.Name)
+		}
+
+		names[k.Name] = struct{}{}
+		keys[k.Key] = struct{}{}
+	}
+
+	return nil
+}
+
+func (de *AuthDesign) Authenticator() *digest.Authenticator {
+	public, _ := parseAPIKeyScopes(de.Public)
+
+	keys := make([]digest.APIKey, len(de.Keys))
+
+	for i := range de.Keys {
+		scopes, _ := parseAPIKeyScopes(de.Keys[i].Scopes)
+
+		keys[i] = digest.NewAPIKey(de.Keys[i].Name, de.Keys[i].Key, scopes)
+	}
+
+	return digest.NewAuthenticator(public, keys)
+}
+
+func parseAPIKeyScopes(l []string) ([]digest.APIKeyScope, error) {
+	scopes := make([]digest.APIKeyScope, len(l))
+
+	for i := range l {
+		scopes[i] = digest.APIKeyScope(l[i])
+		if err := scopes[i].IsValid(nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return scopes, nil
+}
+
+// CORSDesign is the allowed origins of digest API, like
+// "https://example.com"; by default, any origin is allowed. The credentials
+// are allowed only when every origin is explicit, not "*".
+type CORSDesign struct {
+	Origins []string `yaml:"origins"`
+}
+
 type DigestDesign struct {
-	NetworkYAML  *LocalNetwork        `yaml:"network,omitempty"`
-	CacheYAML    *string              `yaml:"cache,omitempty"`
-	DatabaseYAML *config.DatabaseYAML `yaml:"database"`
-	network      config.LocalNetwork
-	database     config.BaseDatabase
-	cache        *url.URL
+	NetworkYAML   *LocalNetwork        `yaml:"network,omitempty"`
+	CacheYAML     *string              `yaml:"cache,omitempty"`
+	DatabaseYAML  *config.DatabaseYAML `yaml:"database"`
+	WebhookYAML   *WebhookDesign       `yaml:"webhook,omitempty"`
+	RateLimitYAML *RateLimitDesign     `yaml:"rate-limit,omitempty"`
+	AuthYAML      *AuthDesign          `yaml:"auth,omitempty"`
+	CORSYAML      *CORSDesign          `yaml:"cors,omitempty"`
+	network       config.LocalNetwork
+	database      config.BaseDatabase
+	cache         *url.URL
 }
 
 func (d *DigestDesign) Set(ctx context.Context) (context.Context, error) {
@@ -319,6 +599,30 @@ func (d *DigestDesign) Set(ctx context.Context) (context.Context, error) {
 		d.database = st
 	}
 
+	if d.WebhookYAML != nil {
+		if err := d.WebhookYAML.IsValid(nil); err != nil {
+			return ctx, e.Wrap(err)
+		}
+	}
+
+	if d.RateLimitYAML != nil {
+		if err := d.RateLimitYAML.IsValid(nil); err != nil {
+			return ctx, e.Wrap(err)
+		}
+	}
+
+	if d.AuthYAML != nil {
+		if err := d.AuthYAML.IsValid(nil); err != nil {
+			return ctx, e.Wrap(err)
+		}
+	}
+
+	if d.CORSYAML != nil {
+		if err := d.network.SetCORSOrigins(d.CORSYAML.Origins); err != nil {
+			return ctx, e.Wrap(err)
+		}
+	}
+
 	return ctx, nil
 }
 
@@ -334,6 +638,21 @@ func (d *DigestDesign) Database() config.BaseDatabase {
 	return d.database
 }
 
+// Webhook returns nil when webhook is not enabled.
+func (d *DigestDesign) Webhook() *WebhookDesign {
+	return d.WebhookYAML
+}
+
+// Auth returns nil when authentication is not enabled.
+func (d *DigestDesign) Auth() *AuthDesign {
+	return d.AuthYAML
+}
+
+// RateLimit returns nil when rate limit is not enabled.
+func (d *DigestDesign) RateLimit() *RateLimitDesign {
+	return d.RateLimitYAML
+}
+
 func (d DigestDesign) MarshalZerologObject(e *zerolog.Event) {
 	e.
 		Interface("network", d.network).
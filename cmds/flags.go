@@ -215,6 +215,43 @@ func (v *CurrencyAmountFlag) String() string {
 	return v.CID.String() + "," + v.Big.String()
 }
 
+type FeeTierFlag struct {
+	Tier types.FeeTier
+}
+
+func (v *FeeTierFlag) UnmarshalText(b []byte) error {
+	l := strings.Split(string(b), ",")
+	if len(l) != 3 && len(l) != 4 {
+		return fmt.Errorf("invalid fee tier, %q", string(b))
+	}
+
+	from, err := common.NewBigFromString(l[0])
+	if err != nil {
+		return errors.Wrapf(err, "invalid from of fee tier, %q", string(b))
+	}
+
+	fixed, err := common.NewBigFromString(l[1])
+	if err != nil {
+		return errors.Wrapf(err, "invalid fixed amount of fee tier, %q", string(b))
+	}
+
+	ratio, err := strconv.ParseFloat(l[2], 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid ratio of fee tier, %q", string(b))
+	}
+
+	max := types.UnlimitedMaxFeeAmount
+	if len(l) == 4 {
+		if max, err = common.NewBigFromString(l[3]); err != nil {
+			return errors.Wrapf(err, "invalid max of fee tier, %q", string(b))
+		}
+	}
+
+	v.Tier = types.NewFeeTier(from, fixed, ratio, max)
+
+	return v.Tier.IsValid(nil)
+}
+
 type ContractIDFlag struct {
 	ID types.ContractID
 }
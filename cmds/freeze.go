@@ -0,0 +1,84 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type FreezeCommand struct {
+	BaseCommand
+	OperationFlags
+	Account  AddressFlag    `arg:"" name:"account" help:"account address" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Node     AddressFlag    `arg:"" name:"node" help:"node address" required:"true"`
+	Unfreeze bool           `name:"unfreeze" help:"unfreeze balance"`
+	account  base.Address
+	node     base.Address
+}
+
+func (cmd *FreezeCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op base.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return errors.Wrap(err, "failed to create freeze operation")
+	} else if err := i.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return errors.Wrap(err, "invalid freeze operation")
+	} else {
+		cmd.Log.Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *FreezeCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Account.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid account format, %q", cmd.Account.String())
+	}
+	cmd.account = a
+
+	a, err = cmd.Node.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
+	}
+	cmd.node = a
+
+	return nil
+}
+
+func (cmd *FreezeCommand) createOperation() (currency.Freeze, error) {
+	fact := currency.NewFreezeFact([]byte(cmd.Token), cmd.account, cmd.Currency.CID, !cmd.Unfreeze)
+
+	op, err := currency.NewFreeze(fact)
+	if err != nil {
+		return currency.Freeze{}, err
+	}
+
+	err = op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node)
+	if err != nil {
+		return currency.Freeze{}, errors.Wrap(err, "failed to create freeze operation")
+	}
+
+	return op, nil
+}
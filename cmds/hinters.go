@@ -4,10 +4,13 @@ import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum-currency/v3/digest"
 	digestisaac "github.com/ProtoconNet/mitum-currency/v3/digest/isaac"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/batch"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/escrow"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/extension"
 	isaacoperation "github.com/ProtoconNet/mitum-currency/v3/operation/isaac"
 	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	stateescrow "github.com/ProtoconNet/mitum-currency/v3/state/escrow"
 	stateextension "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/launch"
@@ -39,11 +42,14 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: types.MEPublickeyHint, Instance: types.MEPublickey{}},
 	{Hint: types.NilFeeerHint, Instance: types.NilFeeer{}},
 	{Hint: types.RatioFeeerHint, Instance: types.RatioFeeer{}},
+	{Hint: types.TieredFeeerHint, Instance: types.TieredFeeer{}},
 
 	{Hint: currency.CreateAccountHint, Instance: currency.CreateAccount{}},
 	{Hint: currency.CreateAccountItemMultiAmountsHint, Instance: currency.CreateAccountItemMultiAmounts{}},
 	{Hint: currency.CreateAccountItemSingleAmountHint, Instance: currency.CreateAccountItemSingleAmount{}},
 	{Hint: currency.UpdateCurrencyHint, Instance: currency.UpdateCurrency{}},
+	{Hint: currency.UpdateFeeRateHint, Instance: currency.UpdateFeeRate{}},
+	{Hint: currency.FreezeHint, Instance: currency.Freeze{}},
 	{Hint: currency.RegisterCurrencyHint, Instance: currency.RegisterCurrency{}},
 	//{Hint: currency.FeeOperationFactHint, Instance: currency.FeeOperationFact{}},
 	//{Hint: currency.FeeOperationHint, Instance: currency.FeeOperation{}},
@@ -51,6 +57,15 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: currency.RegisterGenesisCurrencyFactHint, Instance: currency.RegisterGenesisCurrencyFact{}},
 	{Hint: currency.UpdateKeyHint, Instance: currency.UpdateKey{}},
 	{Hint: currency.MintHint, Instance: currency.Mint{}},
+	{Hint: currency.BurnHint, Instance: currency.Burn{}},
+	{Hint: currency.CreateVestingHint, Instance: currency.CreateVesting{}},
+	{Hint: currency.ClaimVestingHint, Instance: currency.ClaimVesting{}},
+	{Hint: currency.ApproveHint, Instance: currency.Approve{}},
+	{Hint: currency.TransferFromHint, Instance: currency.TransferFrom{}},
+	{Hint: currency.RegisterStandingOrderHint, Instance: currency.RegisterStandingOrder{}},
+	{Hint: currency.CancelStandingOrderHint, Instance: currency.CancelStandingOrder{}},
+	{Hint: currency.PayStandingOrdersHint, Instance: currency.PayStandingOrders{}},
+	{Hint: batch.BatchHint, Instance: batch.Batch{}},
 	{Hint: currency.TransferHint, Instance: currency.Transfer{}},
 	{Hint: currency.TransferItemMultiAmountsHint, Instance: currency.TransferItemMultiAmounts{}},
 	{Hint: currency.TransferItemSingleAmountHint, Instance: currency.TransferItemSingleAmount{}},
@@ -58,10 +73,16 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: extension.CreateContractAccountHint, Instance: extension.CreateContractAccount{}},
 	{Hint: extension.CreateContractAccountItemMultiAmountsHint, Instance: extension.CreateContractAccountItemMultiAmounts{}},
 	{Hint: extension.CreateContractAccountItemSingleAmountHint, Instance: extension.CreateContractAccountItemSingleAmount{}},
+	{Hint: extension.UpdateContractAccountOwnerHint, Instance: extension.UpdateContractAccountOwner{}},
+	{Hint: extension.UpdateOperatorHint, Instance: extension.UpdateOperator{}},
 	{Hint: extension.WithdrawHint, Instance: extension.Withdraw{}},
 	{Hint: extension.WithdrawItemMultiAmountsHint, Instance: extension.WithdrawItemMultiAmounts{}},
 	{Hint: extension.WithdrawItemSingleAmountHint, Instance: extension.WithdrawItemSingleAmount{}},
 
+	{Hint: escrow.CreateEscrowHint, Instance: escrow.CreateEscrow{}},
+	{Hint: escrow.ClaimEscrowHint, Instance: escrow.ClaimEscrow{}},
+	{Hint: escrow.RefundEscrowHint, Instance: escrow.RefundEscrow{}},
+
 	{Hint: isaacoperation.GenesisNetworkPolicyHint, Instance: isaacoperation.GenesisNetworkPolicy{}},
 	{Hint: isaacoperation.FixedSuffrageCandidateLimiterRuleHint, Instance: isaacoperation.FixedSuffrageCandidateLimiterRule{}},
 	{Hint: isaacoperation.MajoritySuffrageCandidateLimiterRuleHint, Instance: isaacoperation.MajoritySuffrageCandidateLimiterRule{}},
@@ -75,9 +96,18 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: statecurrency.AccountStateValueHint, Instance: statecurrency.AccountStateValue{}},
 	{Hint: statecurrency.BalanceStateValueHint, Instance: statecurrency.BalanceStateValue{}},
 	{Hint: statecurrency.CurrencyDesignStateValueHint, Instance: statecurrency.CurrencyDesignStateValue{}},
+	{Hint: statecurrency.FeeRateStateValueHint, Instance: statecurrency.FeeRateStateValue{}},
+	{Hint: statecurrency.FrozenStateValueHint, Instance: statecurrency.FrozenStateValue{}},
+	{Hint: statecurrency.VestingStateValueHint, Instance: statecurrency.VestingStateValue{}},
+	{Hint: statecurrency.AllowanceStateValueHint, Instance: statecurrency.AllowanceStateValue{}},
+	{Hint: statecurrency.StandingOrderStateValueHint, Instance: statecurrency.StandingOrderStateValue{}},
+	{Hint: statecurrency.StandingOrderDueStateValueHint, Instance: statecurrency.StandingOrderDueStateValue{}},
+	{Hint: statecurrency.StandingOrderCursorStateValueHint, Instance: statecurrency.StandingOrderCursorStateValue{}},
 
 	{Hint: stateextension.ContractAccountStateValueHint, Instance: stateextension.ContractAccountStateValue{}},
 
+	{Hint: stateescrow.EscrowStateValueHint, Instance: stateescrow.EscrowStateValue{}},
+
 	{Hint: digest.AccountValueHint, Instance: digest.AccountValue{}},
 	{Hint: digest.OperationValueHint, Instance: digest.OperationValue{}},
 	{Hint: digestisaac.ManifestHint, Instance: digestisaac.Manifest{}},
@@ -86,14 +116,31 @@ var AddedHinters = []encoder.DecodeDetail{
 var AddedSupportedHinters = []encoder.DecodeDetail{
 	{Hint: currency.CreateAccountFactHint, Instance: currency.CreateAccountFact{}},
 	{Hint: currency.UpdateCurrencyFactHint, Instance: currency.UpdateCurrencyFact{}},
+	{Hint: currency.UpdateFeeRateFactHint, Instance: currency.UpdateFeeRateFact{}},
+	{Hint: currency.FreezeFactHint, Instance: currency.FreezeFact{}},
 	{Hint: currency.RegisterCurrencyFactHint, Instance: currency.RegisterCurrencyFact{}},
 	{Hint: currency.UpdateKeyFactHint, Instance: currency.UpdateKeyFact{}},
 	{Hint: currency.MintFactHint, Instance: currency.MintFact{}},
+	{Hint: currency.BurnFactHint, Instance: currency.BurnFact{}},
+	{Hint: currency.CreateVestingFactHint, Instance: currency.CreateVestingFact{}},
+	{Hint: currency.ClaimVestingFactHint, Instance: currency.ClaimVestingFact{}},
+	{Hint: currency.ApproveFactHint, Instance: currency.ApproveFact{}},
+	{Hint: currency.TransferFromFactHint, Instance: currency.TransferFromFact{}},
+	{Hint: currency.RegisterStandingOrderFactHint, Instance: currency.RegisterStandingOrderFact{}},
+	{Hint: currency.CancelStandingOrderFactHint, Instance: currency.CancelStandingOrderFact{}},
+	{Hint: currency.PayStandingOrdersFactHint, Instance: currency.PayStandingOrdersFact{}},
+	{Hint: batch.BatchFactHint, Instance: batch.BatchFact{}},
 	{Hint: currency.TransferFactHint, Instance: currency.TransferFact{}},
 
 	{Hint: extension.CreateContractAccountFactHint, Instance: extension.CreateContractAccountFact{}},
+	{Hint: extension.UpdateContractAccountOwnerFactHint, Instance: extension.UpdateContractAccountOwnerFact{}},
+	{Hint: extension.UpdateOperatorFactHint, Instance: extension.UpdateOperatorFact{}},
 	{Hint: extension.WithdrawFactHint, Instance: extension.WithdrawFact{}},
 
+	{Hint: escrow.CreateEscrowFactHint, Instance: escrow.CreateEscrowFact{}},
+	{Hint: escrow.ClaimEscrowFactHint, Instance: escrow.ClaimEscrowFact{}},
+	{Hint: escrow.RefundEscrowFactHint, Instance: escrow.RefundEscrowFact{}},
+
 	{Hint: isaacoperation.GenesisNetworkPolicyFactHint, Instance: isaacoperation.GenesisNetworkPolicyFact{}},
 	{Hint: isaacoperation.SuffrageCandidateFactHint, Instance: isaacoperation.SuffrageCandidateFact{}},
 	{Hint: isaacoperation.SuffrageDisjoinFactHint, Instance: isaacoperation.SuffrageDisjoinFact{}},
@@ -9,6 +9,7 @@ import (
 	isaacdatabase "github.com/ProtoconNet/mitum2/isaac/database"
 	"github.com/ProtoconNet/mitum2/launch"
 	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/fixedtree"
 	"github.com/ProtoconNet/mitum2/util/logging"
 	"github.com/ProtoconNet/mitum2/util/ps"
@@ -44,6 +45,23 @@ func ProcessDigester(ctx context.Context) (context.Context, error) {
 	di := digest.NewDigester(st, root, nil)
 	_ = di.SetLogging(log)
 
+	var ddesign DigestDesign
+	if err := util.LoadFromContext(ctx, ContextValueDigestDesign, &ddesign); err != nil {
+		return ctx, err
+	}
+
+	if wdesign := ddesign.Webhook(); wdesign != nil {
+		var enc encoder.Encoder
+		if err := util.LoadFromContextOK(ctx, launch.EncoderContextKey, &enc); err != nil {
+			return ctx, err
+		}
+
+		wd := digest.NewWebhookDispatcher(st, enc, *wdesign.Retries)
+		_ = wd.SetLogging(log)
+
+		_ = di.SetWebhookDispatcher(wd)
+	}
+
 	return context.WithValue(ctx, ContextValueDigester, di), nil
 }
 
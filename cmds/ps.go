@@ -7,7 +7,9 @@ import (
 	"path/filepath"
 
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/batch"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/escrow"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/extension"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/processor"
 	"github.com/ProtoconNet/mitum2/base"
@@ -47,56 +49,10 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 
 	set := hint.NewCompatibleSet[isaac.NewOperationProcessorInternalFunc](1 << 9)
 
-	opr := processor.NewOperationProcessor()
-	err = opr.SetCheckDuplicationFunc(processor.CheckDuplication)
-	if err != nil {
-		return pctx, err
-	}
-	err = opr.SetGetNewProcessorFunc(processor.GetNewProcessor)
+	opr, err := NewCurrencyOperationProcessor(isaacParams)
 	if err != nil {
 		return pctx, err
 	}
-	if err := opr.SetProcessor(
-		currency.CreateAccountHint,
-		currency.NewCreateAccountProcessor(),
-	); err != nil {
-		return pctx, err
-	} else if err := opr.SetProcessor(
-		currency.UpdateKeyHint,
-		currency.NewUpdateKeyProcessor(),
-	); err != nil {
-		return pctx, err
-	} else if err := opr.SetProcessor(
-		currency.TransferHint,
-		currency.NewTransferProcessor(),
-	); err != nil {
-		return pctx, err
-	} else if err := opr.SetProcessor(
-		currency.RegisterCurrencyHint,
-		currency.NewRegisterCurrencyProcessor(isaacParams.Threshold()),
-	); err != nil {
-		return pctx, err
-	} else if err := opr.SetProcessor(
-		currency.UpdateCurrencyHint,
-		currency.NewUpdateCurrencyProcessor(isaacParams.Threshold()),
-	); err != nil {
-		return pctx, err
-	} else if err := opr.SetProcessor(
-		currency.MintHint,
-		currency.NewMintProcessor(isaacParams.Threshold()),
-	); err != nil {
-		return pctx, err
-	} else if err := opr.SetProcessor(
-		extension.CreateContractAccountHint,
-		extension.NewCreateContractAccountProcessor(),
-	); err != nil {
-		return pctx, err
-	} else if err := opr.SetProcessor(
-		extension.WithdrawHint,
-		extension.NewWithdrawProcessor(),
-	); err != nil {
-		return pctx, err
-	}
 
 	_ = set.Add(currency.CreateAccountHint, func(height base.Height) (base.OperationProcessor, error) {
 		return opr.New(
@@ -143,6 +99,24 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		)
 	})
 
+	_ = set.Add(currency.UpdateFeeRateHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.FreezeHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
 	_ = set.Add(currency.MintHint, func(height base.Height) (base.OperationProcessor, error) {
 		return opr.New(
 			height,
@@ -152,6 +126,87 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		)
 	})
 
+	_ = set.Add(currency.BurnHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.CreateVestingHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ClaimVestingHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ApproveHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.TransferFromHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.RegisterStandingOrderHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.CancelStandingOrderHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.PayStandingOrdersHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(batch.BatchHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
 	_ = set.Add(extension.CreateContractAccountHint, func(height base.Height) (base.OperationProcessor, error) {
 		return opr.New(
 			height,
@@ -170,6 +225,51 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		)
 	})
 
+	_ = set.Add(extension.UpdateOperatorHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(extension.UpdateContractAccountOwnerHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(escrow.CreateEscrowHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(escrow.ClaimEscrowHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(escrow.RefundEscrowHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
 	_ = set.Add(isaacoperation.SuffrageCandidateHint, func(height base.Height) (base.OperationProcessor, error) {
 		policy := db.LastNetworkPolicy()
 		if policy == nil { // NOTE Usually it means empty block data
@@ -232,6 +332,138 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 	return pctx, nil
 }
 
+// NewCurrencyOperationProcessor returns the OperationProcessor, which has the
+// processors of the currency operations.
+func NewCurrencyOperationProcessor(isaacParams *isaac.Params) (*processor.OperationProcessor, error) {
+	opr := processor.NewOperationProcessor()
+	err := opr.SetCheckDuplicationFunc(processor.CheckDuplication)
+	if err != nil {
+		return nil, err
+	}
+	err = opr.SetGetNewProcessorFunc(processor.GetNewProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if err := opr.SetProcessor(
+		currency.CreateAccountHint,
+		currency.NewCreateAccountProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.UpdateKeyHint,
+		currency.NewUpdateKeyProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.TransferHint,
+		currency.NewTransferProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.RegisterCurrencyHint,
+		currency.NewRegisterCurrencyProcessor(isaacParams.Threshold()),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.UpdateCurrencyHint,
+		currency.NewUpdateCurrencyProcessor(isaacParams.Threshold()),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.UpdateFeeRateHint,
+		currency.NewUpdateFeeRateProcessor(isaacParams.Threshold()),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.FreezeHint,
+		currency.NewFreezeProcessor(isaacParams.Threshold()),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.MintHint,
+		currency.NewMintProcessor(isaacParams.Threshold()),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.BurnHint,
+		currency.NewBurnProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.CreateVestingHint,
+		currency.NewCreateVestingProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.ClaimVestingHint,
+		currency.NewClaimVestingProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.ApproveHint,
+		currency.NewApproveProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.TransferFromHint,
+		currency.NewTransferFromProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.RegisterStandingOrderHint,
+		currency.NewRegisterStandingOrderProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.CancelStandingOrderHint,
+		currency.NewCancelStandingOrderProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		currency.PayStandingOrdersHint,
+		currency.NewPayStandingOrdersProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		extension.CreateContractAccountHint,
+		extension.NewCreateContractAccountProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		extension.WithdrawHint,
+		extension.NewWithdrawProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		extension.UpdateOperatorHint,
+		extension.NewUpdateOperatorProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		extension.UpdateContractAccountOwnerHint,
+		extension.NewUpdateContractAccountOwnerProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		escrow.CreateEscrowHint,
+		escrow.NewCreateEscrowProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		escrow.ClaimEscrowHint,
+		escrow.NewClaimEscrowProcessor(),
+	); err != nil {
+		return nil, err
+	} else if err := opr.SetProcessor(
+		escrow.RefundEscrowHint,
+		escrow.NewRefundEscrowProcessor(),
+	); err != nil {
+		return nil, err
+	}
+
+	return opr, nil
+}
+
 func PGenerateGenesis(pctx context.Context) (context.Context, error) {
 	e := util.StringError("generate genesis block")
 
@@ -40,7 +40,8 @@ func DefaultRunPS() *ps.PS {
 			launch.PNameStates).
 		AddOK(PNameMongoDBsDataBase, ProcessDatabase, nil, PNameDigestDesign, launch.PNameStorage).
 		AddOK(PNameDigester, ProcessDigester, nil, PNameMongoDBsDataBase).
-		AddOK(PNameDigest, ProcessDigestAPI, nil, PNameDigestDesign, PNameMongoDBsDataBase, launch.PNameMemberlist).
+		AddOK(PNameDigest, ProcessDigestAPI, nil,
+			PNameDigestDesign, PNameMongoDBsDataBase, PNameDigester, launch.PNameMemberlist, launch.PNameStates).
 		AddOK(PNameDigestStart, ProcessStartDigestAPI, nil, PNameDigest).
 		AddOK(PNameStartDigester, ProcessStartDigester, nil, PNameDigestStart)
 
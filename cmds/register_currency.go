@@ -60,8 +60,39 @@ func (fl *CurrencyRatioFeeerFlags) IsValid([]byte) error {
 	return fl.feeer.IsValid(nil)
 }
 
+type CurrencyTieredFeeerFlags struct {
+	Receiver AddressFlag   `name:"receiver" help:"fee receiver account address"`
+	Tiers    []FeeTierFlag `name:"tier" help:"fee tier (ex: \"<from>,<fixed>,<ratio>[,<max>]\")"`
+	feeer    types.Feeer
+}
+
+func (fl *CurrencyTieredFeeerFlags) IsValid([]byte) error {
+	if len(fl.Receiver.String()) < 1 {
+		return nil
+	}
+
+	var receiver base.Address
+	if a, err := fl.Receiver.Encode(enc); err != nil {
+		return util.ErrInvalid.Errorf("invalid receiver format, %v: %v", fl.Receiver.String(), err)
+	} else if err := a.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid receiver address, %v: %v", fl.Receiver.String(), err)
+	} else {
+		receiver = a
+	}
+
+	tiers := make([]types.FeeTier, len(fl.Tiers))
+	for i := range fl.Tiers {
+		tiers[i] = fl.Tiers[i].Tier
+	}
+
+	fl.feeer = types.NewTieredFeeer(receiver, tiers)
+	return fl.feeer.IsValid(nil)
+}
+
 type CurrencyPolicyFlags struct {
 	NewAccountMinBalance BigFlag `name:"new-account-min-balance" help:"minimum balance for new account"` // nolint lll
+	MaxMemoSize          uint    `name:"max-memo-size" help:"maximum memo size; 0 means the default size"`
+	MaxSupply            BigFlag `name:"max-supply" help:"maximum supply; 0 means no cap; once set, it can only be lowered" default:"0"`
 }
 
 func (*CurrencyPolicyFlags) IsValid([]byte) error {
@@ -69,14 +100,15 @@ func (*CurrencyPolicyFlags) IsValid([]byte) error {
 }
 
 type CurrencyDesignFlags struct {
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	GenesisAmount           BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:"true"`
-	GenesisAccount          AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:"true"` // nolint lll
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:"true"`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed, ratio}" required:"true"`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	CurrencyRatioFeeerFlags `prefix:"feeer-ratio-" help:"ratio feeer"`
-	currencyDesign          types.CurrencyDesign
+	Currency                 CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	GenesisAmount            BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:"true"`
+	GenesisAccount           AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:"true"` // nolint lll
+	CurrencyPolicyFlags      `prefix:"policy-" help:"currency policy" required:"true"`
+	FeeerString              string `name:"feeer" help:"feeer type, {nil, fixed, ratio, tiered}" required:"true"`
+	CurrencyFixedFeeerFlags  `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags  `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyTieredFeeerFlags `prefix:"feeer-tiered-" help:"tiered feeer"`
+	currencyDesign           types.CurrencyDesign
 }
 
 func (fl *CurrencyDesignFlags) IsValid([]byte) error {
@@ -86,6 +118,8 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	} else if err := fl.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := fl.CurrencyTieredFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	var feeer types.Feeer
@@ -96,6 +130,8 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		feeer = fl.CurrencyFixedFeeerFlags.feeer
 	case types.FeeerRatio:
 		feeer = fl.CurrencyRatioFeeerFlags.feeer
+	case types.FeeerTiered:
+		feeer = fl.CurrencyTieredFeeerFlags.feeer
 	default:
 		return util.ErrInvalid.Errorf("unknown feeer type, %v", t)
 	}
@@ -106,7 +142,9 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	}
 
-	po := types.NewCurrencyPolicy(fl.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	po := types.NewCurrencyPolicy(fl.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer).
+		SetMaxMemoSize(fl.CurrencyPolicyFlags.MaxMemoSize).
+		SetMaxSupply(fl.CurrencyPolicyFlags.MaxSupply.Big)
 	if err := po.IsValid(nil); err != nil {
 		return err
 	}
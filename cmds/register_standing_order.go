@@ -0,0 +1,106 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type RegisterStandingOrderCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender      AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	ID          string             `arg:"" name:"standing-order-id" help:"standing order id" required:"true"`
+	Receiver    AddressFlag        `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amount      CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount of each transfer (ex: \"<currency>,<amount>\")" required:"true"`
+	Start       uint64             `arg:"" name:"start" help:"height of first transfer" required:"true"`
+	Interval    uint64             `arg:"" name:"interval" help:"heights between transfers" required:"true"`
+	Count       uint64             `arg:"" name:"count" help:"number of transfers" required:"true"`
+	FeeCurrency CurrencyIDFlag     `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag        `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	receiver    base.Address
+	feePayer    base.Address
+}
+
+func (cmd *RegisterStandingOrderCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RegisterStandingOrderCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	a, err = cmd.Receiver.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %v", cmd.Receiver.String())
+	}
+	cmd.receiver = a
+
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
+	return nil
+}
+
+func (cmd *RegisterStandingOrderCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	am := types.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewRegisterStandingOrderFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		types.StandingOrderID(cmd.ID),
+		cmd.receiver,
+		am,
+		base.Height(cmd.Start),
+		cmd.Interval,
+		cmd.Count,
+	).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
+
+	op, err := currency.NewRegisterStandingOrder(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create register-standing-order operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create register-standing-order operation")
+	}
+
+	return op, nil
+}
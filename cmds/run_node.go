@@ -3,8 +3,11 @@ package cmds
 import (
 	"context"
 	"github.com/ProtoconNet/mitum-currency/v3/digest"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/isaac"
+	isaacdatabase "github.com/ProtoconNet/mitum2/isaac/database"
 	isaacnetwork "github.com/ProtoconNet/mitum2/isaac/network"
 	isaacstates "github.com/ProtoconNet/mitum2/isaac/states"
 	"github.com/ProtoconNet/mitum2/launch"
@@ -175,9 +178,17 @@ func (cmd *RunCommand) runStates(ctx, pctx context.Context) (func(), error) {
 
 func (cmd *RunCommand) pWhenNewBlockSavedInConsensusStateFunc(pctx context.Context) (context.Context, error) {
 	var log *logging.Logging
+	var local base.LocalNode
+	var isaacParams *isaac.Params
+	var db isaac.Database
+	var pool *isaacdatabase.TempPool
 
 	if err := util.LoadFromContextOK(pctx,
 		launch.LoggingContextKey, &log,
+		launch.LocalContextKey, &local,
+		launch.ISAACParamsContextKey, &isaacParams,
+		launch.CenterDatabaseContextKey, &db,
+		launch.PoolDatabaseContextKey, &pool,
 	); err != nil {
 		return pctx, err
 	}
@@ -195,11 +206,57 @@ func (cmd *RunCommand) pWhenNewBlockSavedInConsensusStateFunc(pctx context.Conte
 
 			return
 		}
+
+		if err := setPayStandingOrdersOperation(
+			bm.Manifest().Height()+1, local, isaacParams.NetworkID(), db, pool); err != nil {
+			l.Error().Err(err).Msg("failed to set pay standing orders operation")
+		}
 	}
 
 	return context.WithValue(pctx, launch.WhenNewBlockSavedInConsensusStateFuncContextKey, f), nil
 }
 
+// setPayStandingOrdersOperation puts the operation, which pays the due standing
+// orders at height, into the pool, like the suffrage nodes generate it for
+// each height; nothing is put when the standing orders are already paid until
+// height.
+func setPayStandingOrdersOperation(
+	height base.Height,
+	local base.LocalNode,
+	networkID base.NetworkID,
+	db isaac.Database,
+	pool *isaacdatabase.TempPool,
+) error {
+	switch st, found, err := db.State(statecurrency.StateKeyStandingOrderCursor); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		cursor, err := statecurrency.StateStandingOrderCursorValue(st)
+		if err != nil {
+			return err
+		}
+
+		if cursor.Height >= height {
+			return nil
+		}
+	}
+
+	op, err := currency.NewPayStandingOrders(currency.NewPayStandingOrdersFact(height))
+	if err != nil {
+		return err
+	}
+
+	if err := op.NodeSign(local.Privatekey(), networkID, local.Address()); err != nil {
+		return err
+	}
+
+	_, err = pool.SetOperation(context.Background(), op)
+
+	return err
+}
+
 func (cmd *RunCommand) pWhenNewBlockConfirmed(pctx context.Context) (context.Context, error) {
 	var log *logging.Logging
 	var db isaac.Database
@@ -385,7 +442,8 @@ func (cmd *RunCommand) setDigestDefaultHandlers(
 		return nil, err
 	}
 
-	handlers := digest.NewHandlers(ctx, params.ISAAC.NetworkID(), encs, enc, st, cache, router)
+	handlers := digest.NewHandlers(ctx, params.ISAAC.NetworkID(), encs, enc, st, cache, router).
+		SetOperationBuilders(Hinters, SupportedProposalOperationFactHinters)
 
 	h, err := cmd.setDigestNetworkClient(ctx, params, handlers)
 	if err != nil {
@@ -393,6 +451,103 @@ func (cmd *RunCommand) setDigestDefaultHandlers(
 	}
 	handlers = h
 
+	h, err = cmd.setDigestStateFuncs(ctx, handlers)
+	if err != nil {
+		return nil, err
+	}
+	handlers = h
+
+	var di *digest.Digester
+	if err := util.LoadFromContext(ctx, ContextValueDigester, &di); err != nil {
+		return nil, err
+	}
+
+	if di != nil {
+		handlers = handlers.SetStreamer(di.Streamer())
+		_ = di.SetPendingOperations(handlers.PendingOperations())
+
+		if ic, ok := cache.(digest.InvalidatableCache); ok {
+			_ = di.SetCache(ic)
+		}
+	}
+
+	var design DigestDesign
+	if err := util.LoadFromContext(ctx, ContextValueDigestDesign, &design); err != nil {
+		return nil, err
+	}
+
+	if wdesign := design.Webhook(); wdesign != nil {
+		handlers = handlers.SetWebhookAdminToken(wdesign.AdminToken)
+	}
+
+	handlers = handlers.SetCORSOrigins(design.Network().CORSOrigins())
+
+	if adesign := design.Auth(); adesign != nil {
+		handlers = handlers.SetAuthenticator(adesign.Authenticator())
+	}
+
+	if rdesign := design.RateLimit(); rdesign != nil {
+		store, err := digest.NewRateLimitStoreFromURI(rdesign.Store)
+		if err != nil {
+			return nil, err
+		}
+
+		handlers = handlers.SetRateLimiter(
+			digest.NewRateLimiter(rdesign.RateLimitRules(), rdesign.ClientRateLimitRules(), store).
+				SetClientIPHeader(rdesign.ClientIPHeader).
+				SetTrustedProxies(rdesign.TrustedProxies),
+		)
+	}
+
+	return handlers, nil
+}
+
+func (cmd *RunCommand) setDigestStateFuncs(
+	ctx context.Context,
+	handlers *digest.Handlers,
+) (*digest.Handlers, error) {
+	var db isaac.Database
+	var isaacParams *isaac.Params
+
+	if err := util.LoadFromContextOK(ctx,
+		launch.CenterDatabaseContextKey, &db,
+		launch.ISAACParamsContextKey, &isaacParams,
+	); err != nil {
+		return nil, err
+	}
+
+	// NOTE the simulation has its own OperationProcessor, so it does not share
+	// the processors with the consensus.
+	opr, err := NewCurrencyOperationProcessor(isaacParams)
+	if err != nil {
+		return nil, err
+	}
+
+	handlers = handlers.SetGetStateFunc(db.State)
+	handlers = handlers.SetSimulateFunc(
+		func(ctx context.Context, op base.Operation) (digest.SimulateResult, error) {
+			var height base.Height
+
+			switch m, found, err := db.LastBlockMap(); {
+			case err != nil:
+				return digest.SimulateResult{}, err
+			case !found:
+				return digest.SimulateResult{}, util.ErrNotFound.Errorf("last block not found")
+			default:
+				height = m.Manifest().Height() + 1
+			}
+
+			opp, err := opr.New(height, db.State, nil, nil)
+			if err != nil {
+				return digest.SimulateResult{}, err
+			}
+
+			return digest.SimulateOperation(ctx, height, opp, op, db.State)
+		},
+	)
+
+	cmd.log.Debug().Msg("simulate and fee handlers attached")
+
 	return handlers, nil
 }
 
@@ -14,11 +14,15 @@ import (
 type TransferCommand struct {
 	BaseCommand
 	OperationFlags
-	Sender   AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
-	Receiver AddressFlag          `arg:"" name:"receiver" help:"receiver address" required:"true"`
-	Amounts  []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
-	sender   base.Address
-	receiver base.Address
+	Sender      AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver    AddressFlag          `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amounts     []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	Memo        string               `name:"memo" help:"memo"`
+	FeeCurrency CurrencyIDFlag       `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag          `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	receiver    base.Address
+	feePayer    base.Address
 }
 
 func (cmd *TransferCommand) Run(pctx context.Context) error {
@@ -61,6 +65,14 @@ func (cmd *TransferCommand) parseFlags() error {
 		cmd.receiver = receiver
 	}
 
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
 	return nil
 }
 
@@ -78,13 +90,13 @@ func (cmd *TransferCommand) createOperation() (base.Operation, error) { // nolin
 		ams[i] = am
 	}
 
-	item := currency.NewTransferItemMultiAmounts(cmd.receiver, ams)
+	item := currency.NewTransferItemMultiAmounts(cmd.receiver, ams).SetMemo(cmd.Memo)
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
 	}
 	items = append(items, item)
 
-	fact := currency.NewTransferFact([]byte(cmd.Token), cmd.sender, items)
+	fact := currency.NewTransferFact([]byte(cmd.Token), cmd.sender, items).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
 
 	op, err := currency.NewTransfer(fact)
 	if err != nil {
@@ -0,0 +1,90 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/extension"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type UpdateContractAccountOwnerCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender      AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    AddressFlag    `arg:"" name:"contract" help:"target contract account address" required:"true"`
+	Owner       AddressFlag    `arg:"" name:"owner" help:"new owner address" required:"true"`
+	Currency    CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	FeeCurrency CurrencyIDFlag `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag    `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	contract    base.Address
+	owner       base.Address
+	feePayer    base.Address
+}
+
+func (cmd *UpdateContractAccountOwnerCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateContractAccountOwnerCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	} else if contract, err := cmd.Contract.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid contract format, %v", cmd.Contract.String())
+	} else if owner, err := cmd.Owner.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid owner format, %v", cmd.Owner.String())
+	} else {
+		cmd.sender = sender
+		cmd.contract = contract
+		cmd.owner = owner
+	}
+
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
+	return nil
+}
+
+func (cmd *UpdateContractAccountOwnerCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := extension.NewUpdateContractAccountOwnerFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.owner, cmd.Currency.CID).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
+
+	op, err := extension.NewUpdateContractAccountOwner(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-contract-account-owner operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-contract-account-owner operation")
+	}
+
+	return op, nil
+}
@@ -12,14 +12,15 @@ import (
 type UpdateCurrencyCommand struct {
 	BaseCommand
 	OperationFlags
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:"true"`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed, ratio}" required:"true"`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	CurrencyRatioFeeerFlags `prefix:"feeer-ratio-" help:"ratio feeer"`
-	Node                    AddressFlag `arg:"" name:"node" help:"node address" required:"true"`
-	node                    base.Address
-	po                      types.CurrencyPolicy
+	Currency                 CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	CurrencyPolicyFlags      `prefix:"policy-" help:"currency policy" required:"true"`
+	FeeerString              string `name:"feeer" help:"feeer type, {nil, fixed, ratio, tiered}" required:"true"`
+	CurrencyFixedFeeerFlags  `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags  `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyTieredFeeerFlags `prefix:"feeer-tiered-" help:"tiered feeer"`
+	Node                     AddressFlag `arg:"" name:"node" help:"node address" required:"true"`
+	node                     base.Address
+	po                       types.CurrencyPolicy
 }
 
 func (cmd *UpdateCurrencyCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -61,6 +62,8 @@ func (cmd *UpdateCurrencyCommand) parseFlags() error {
 		return err
 	} else if err := cmd.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := cmd.CurrencyTieredFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	a, err := cmd.Node.Encode(enc)
@@ -77,6 +80,8 @@ func (cmd *UpdateCurrencyCommand) parseFlags() error {
 		feeer = cmd.CurrencyFixedFeeerFlags.feeer
 	case types.FeeerRatio:
 		feeer = cmd.CurrencyRatioFeeerFlags.feeer
+	case types.FeeerTiered:
+		feeer = cmd.CurrencyTieredFeeerFlags.feeer
 	default:
 		return errors.Errorf("unknown feeer type, %q", t)
 	}
@@ -87,7 +92,9 @@ func (cmd *UpdateCurrencyCommand) parseFlags() error {
 		return err
 	}
 
-	cmd.po = types.NewCurrencyPolicy(cmd.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	cmd.po = types.NewCurrencyPolicy(cmd.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer).
+		SetMaxMemoSize(cmd.CurrencyPolicyFlags.MaxMemoSize).
+		SetMaxSupply(cmd.CurrencyPolicyFlags.MaxSupply.Big)
 	if err := cmd.po.IsValid(nil); err != nil {
 		return err
 	}
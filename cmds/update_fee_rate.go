@@ -0,0 +1,78 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type UpdateFeeRateCommand struct {
+	BaseCommand
+	OperationFlags
+	Currency    CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	FeeCurrency CurrencyIDFlag `arg:"" name:"fee-currency-id" help:"currency id to pay fee in" required:"true"`
+	Rate        types.FeeRate  `arg:"" name:"rate" help:"fee in fee currency per fee in currency, like \"1/3\" or \"0.5\"; 0 disables the fee currency" required:"true"`
+	Node        AddressFlag    `arg:"" name:"node" help:"node address" required:"true"`
+	node        base.Address
+}
+
+func (cmd *UpdateFeeRateCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op base.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return errors.Wrap(err, "failed to create update-fee-rate operation")
+	} else if err := i.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return errors.Wrap(err, "invalid update-fee-rate operation")
+	} else {
+		cmd.Log.Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateFeeRateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Node.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
+	}
+	cmd.node = a
+
+	return nil
+}
+
+func (cmd *UpdateFeeRateCommand) createOperation() (currency.UpdateFeeRate, error) {
+	fact := currency.NewUpdateFeeRateFact([]byte(cmd.Token), cmd.Currency.CID, cmd.FeeCurrency.CID, cmd.Rate)
+
+	op, err := currency.NewUpdateFeeRate(fact)
+	if err != nil {
+		return currency.UpdateFeeRate{}, err
+	}
+
+	err = op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node)
+	if err != nil {
+		return currency.UpdateFeeRate{}, errors.Wrap(err, "failed to create update-fee-rate operation")
+	}
+
+	return op, nil
+}
@@ -13,12 +13,15 @@ import (
 type UpdateKeyCommand struct {
 	BaseCommand
 	OperationFlags
-	Target    AddressFlag    `arg:"" name:"target" help:"target address" required:"true"`
-	Threshold uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
-	Keys      []KeyFlag      `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
-	Currency  CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	target    base.Address
-	keys      types.BaseAccountKeys
+	Target      AddressFlag    `arg:"" name:"target" help:"target address" required:"true"`
+	Threshold   uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
+	Keys        []KeyFlag      `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
+	Currency    CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	FeeCurrency CurrencyIDFlag `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag    `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	target      base.Address
+	keys        types.BaseAccountKeys
+	feePayer    base.Address
 }
 
 func (cmd *UpdateKeyCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -73,11 +76,19 @@ func (cmd *UpdateKeyCommand) parseFlags() error {
 		}
 	}
 
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
 	return nil
 }
 
 func (cmd *UpdateKeyCommand) createOperation() (base.Operation, error) { // nolint:dupl}
-	fact := currency.NewUpdateKeyFact([]byte(cmd.Token), cmd.target, cmd.keys, cmd.Currency.CID)
+	fact := currency.NewUpdateKeyFact([]byte(cmd.Token), cmd.target, cmd.keys, cmd.Currency.CID).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
 
 	op, err := currency.NewUpdateKey(fact)
 	if err != nil {
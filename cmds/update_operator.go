@@ -0,0 +1,98 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/extension"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type UpdateOperatorCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender      AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    AddressFlag    `arg:"" name:"contract" help:"target contract account address" required:"true"`
+	Currency    CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Operators   []AddressFlag  `name:"operator" help:"operator address; empty operators remove every operator"`
+	FeeCurrency CurrencyIDFlag `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag    `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	contract    base.Address
+	operators   []base.Address
+	feePayer    base.Address
+}
+
+func (cmd *UpdateOperatorCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateOperatorCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	} else if contract, err := cmd.Contract.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid contract format, %v", cmd.Contract.String())
+	} else {
+		cmd.sender = sender
+		cmd.contract = contract
+	}
+
+	operators := make([]base.Address, len(cmd.Operators))
+	for i := range cmd.Operators {
+		a, err := cmd.Operators[i].Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid operator format, %v", cmd.Operators[i].String())
+		}
+
+		operators[i] = a
+	}
+	cmd.operators = operators
+
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
+	return nil
+}
+
+func (cmd *UpdateOperatorCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := extension.NewUpdateOperatorFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.operators, cmd.Currency.CID).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
+
+	op, err := extension.NewUpdateOperator(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-operator operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-operator operation")
+	}
+
+	return op, nil
+}
@@ -14,11 +14,14 @@ import (
 type WithdrawCommand struct {
 	BaseCommand
 	OperationFlags
-	Sender  AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
-	Target  AddressFlag          `arg:"" name:"target" help:"target contract account address" required:"true"`
-	Amounts []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
-	sender  base.Address
-	target  base.Address
+	Sender      AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
+	Target      AddressFlag          `arg:"" name:"target" help:"target contract account address" required:"true"`
+	Amounts     []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	FeeCurrency CurrencyIDFlag       `name:"fee-currency" help:"currency id to pay fee in"`
+	FeePayer    AddressFlag          `name:"fee-payer" help:"fee payer address; fee payer should also sign the operation"`
+	sender      base.Address
+	target      base.Address
+	feePayer    base.Address
 }
 
 func (cmd *WithdrawCommand) Run(pctx context.Context) error {
@@ -61,6 +64,14 @@ func (cmd *WithdrawCommand) parseFlags() error {
 		cmd.target = target
 	}
 
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %v", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
 	return nil
 }
 
@@ -84,7 +95,7 @@ func (cmd *WithdrawCommand) createOperation() (base.Operation, error) { // nolin
 	}
 	items = append(items, item)
 
-	fact := extension.NewWithdrawFact([]byte(cmd.Token), cmd.sender, items)
+	fact := extension.NewWithdrawFact([]byte(cmd.Token), cmd.sender, items).SetFeeCurrency(cmd.FeeCurrency.CID).SetFeePayer(cmd.feePayer)
 
 	op, err := extension.NewWithdraw(fact)
 	if err != nil {
@@ -37,6 +37,12 @@ func (op BaseOperation) Signs() []base.Sign {
 	return op.signs
 }
 
+// SetSigns replaces the signs; it is used for the operation, which is not
+// signed by itself, like the item of batch.
+func (op *BaseOperation) SetSigns(signs []base.Sign) {
+	op.signs = signs
+}
+
 func (op BaseOperation) Fact() base.Fact {
 	return op.fact
 }
@@ -16,7 +16,9 @@ type AccountValue struct {
 	ac      types.Account
 	balance []types.Amount
 	height  base.Height
-	//contractAccountStatus types.ContractAccountStatus
+	// contractAccountStatus is loaded from the contract account collection,
+	// not stored with the account.
+	contractAccountStatus *types.ContractAccountStatus
 }
 
 func NewAccountValue(st base.State) (AccountValue, error) {
@@ -34,7 +36,6 @@ func NewAccountValue(st base.State) (AccountValue, error) {
 		BaseHinter: hint.NewBaseHinter(AccountValueHint),
 		ac:         ac,
 		height:     st.Height(),
-		//contractAccountStatus: types.ContractAccountStatus{},
 	}, nil
 }
 
@@ -46,9 +47,15 @@ func (va AccountValue) Balance() []types.Amount {
 	return va.balance
 }
 
-//func (va AccountValue) ContractAccountStatus() types.ContractAccountStatus {
-//	return va.contractAccountStatus
-//}
+// ContractAccountStatus returns the status of contract account; false if the
+// account is not contract account.
+func (va AccountValue) ContractAccountStatus() (types.ContractAccountStatus, bool) {
+	if va.contractAccountStatus == nil {
+		return types.ContractAccountStatus{}, false
+	}
+
+	return *va.contractAccountStatus, true
+}
 
 func (va AccountValue) Height() base.Height {
 	return va.height
@@ -66,8 +73,8 @@ func (va AccountValue) SetBalance(balance []types.Amount) AccountValue {
 	return va
 }
 
-//func (va AccountValue) SetContractAccountStatus(status types.ContractAccountStatus) AccountValue {
-//	va.contractAccountStatus = status
-//
-//	return va
-//}
+func (va AccountValue) SetContractAccountStatus(status types.ContractAccountStatus) AccountValue {
+	va.contractAccountStatus = &status
+
+	return va
+}
@@ -9,24 +9,12 @@ import (
 )
 
 func (va AccountValue) MarshalBSON() ([]byte, error) {
-	//if &va.contractAccountStatus == nil {
-	//	return bsonenc.Marshal(bsonenc.MergeBSONM(
-	//		bson.M{
-	//			"_hint":   va.Hint().String(),
-	//			"ac":      va.ac,
-	//			"balance": va.balance,
-	//			"height":  va.height,
-	//		},
-	//	))
-	//}
-
 	return bsonenc.Marshal(bsonenc.MergeBSONM(
 		bson.M{
 			"_hint":   va.Hint().String(),
 			"ac":      va.ac,
 			"balance": va.balance,
 			"height":  va.height,
-			//"contract_account_status": va.contractAccountStatus,
 		},
 	))
 }
@@ -36,7 +24,6 @@ type AccountValueBSONUnmarshaler struct {
 	Account bson.Raw    `bson:"ac"`
 	Balance bson.Raw    `bson:"balance"`
 	Height  base.Height `bson:"height"`
-	//ContractAccountStatus bson.Raw    `bson:"contract_account_status"`
 }
 
 func (va *AccountValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -52,5 +39,5 @@ func (va *AccountValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return va.unpack(enc, ht, uva.Account, uva.Balance, uva.Height /* uva.ContractAccountStatus */)
+	return va.unpack(enc, ht, uva.Account, uva.Balance, uva.Height, nil)
 }
@@ -13,7 +13,7 @@ func (va *AccountValue) unpack(
 	ht hint.Hint,
 	bac, bl []byte,
 	height base.Height,
-	// cas []byte,
+	cas []byte,
 ) error {
 	va.BaseHinter = hint.NewBaseHinter(ht)
 	ac, err := enc.Decode(bac)
@@ -45,17 +45,17 @@ func (va *AccountValue) unpack(
 	va.balance = balance
 	va.height = height
 
-	//status, err := enc.Decode(cas)
-	//switch {
-	//case err != nil:
-	//	return err
-	//case status != nil:
-	//	if v, ok := status.(types.ContractAccountStatus); !ok {
-	//		return errors.Errorf("expected ContractAccountStatus, not %T", status)
-	//	} else {
-	//		va.contractAccountStatus = v
-	//	}
-	//}
+	status, err := enc.Decode(cas)
+	switch {
+	case err != nil:
+		return err
+	case status != nil:
+		if v, ok := status.(types.ContractAccountStatus); !ok {
+			return errors.Errorf("expected ContractAccountStatus, not %T", status)
+		} else {
+			va.contractAccountStatus = &v
+		}
+	}
 
 	return nil
 }
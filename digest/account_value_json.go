@@ -12,26 +12,26 @@ import (
 type AccountValueJSONMarshaler struct {
 	hint.BaseHinter
 	types.AccountJSONMarshaler
-	Balance []types.Amount `json:"balance,omitempty"`
-	Height  base.Height    `json:"height"`
-	//ContractAccountStatus types.ContractAccountStatus `json:"contract_account_status"`
+	Balance               []types.Amount               `json:"balance,omitempty"`
+	Height                base.Height                  `json:"height"`
+	ContractAccountStatus *types.ContractAccountStatus `json:"contract_account_status,omitempty"`
 }
 
 func (va AccountValue) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(AccountValueJSONMarshaler{
-		BaseHinter:           va.BaseHinter,
-		AccountJSONMarshaler: va.ac.EncodeJSON(),
-		Balance:              va.balance,
-		Height:               va.height,
-		//ContractAccountStatus: va.contractAccountStatus,
+		BaseHinter:            va.BaseHinter,
+		AccountJSONMarshaler:  va.ac.EncodeJSON(),
+		Balance:               va.balance,
+		Height:                va.height,
+		ContractAccountStatus: va.contractAccountStatus,
 	})
 }
 
 type AccountValueJSONUnmarshaler struct {
-	Hint    hint.Hint
-	Balance json.RawMessage `json:"balance"`
-	Height  base.Height     `json:"height"`
-	//ContractAccountStatus json.RawMessage `json:"contract_account_status"`
+	Hint                  hint.Hint
+	Balance               json.RawMessage `json:"balance"`
+	Height                base.Height     `json:"height"`
+	ContractAccountStatus json.RawMessage `json:"contract_account_status"`
 }
 
 func (va *AccountValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -41,7 +41,7 @@ func (va *AccountValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 	}
 
 	ac := new(types.Account)
-	if err := va.unpack(enc, uva.Hint, nil, uva.Balance, uva.Height /* uva.ContractAccountStatus */); err != nil {
+	if err := va.unpack(enc, uva.Hint, nil, uva.Balance, uva.Height, uva.ContractAccountStatus); err != nil {
 		return err
 	} else if err := ac.DecodeJSON(b, enc); err != nil {
 		return err
@@ -0,0 +1,130 @@
+package digest
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"strings"
+
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var (
+	APIKeyHeader                          = http.CanonicalHeaderKey("x-api-key")
+	APIKeyContextKey mitumutil.ContextKey = "digest-api-key"
+)
+
+type APIKeyScope string
+
+const (
+	// APIKeyScopeRead allows the routes for reading blocks, accounts and
+	// currencies, and building operations.
+	APIKeyScopeRead = APIKeyScope("read")
+	// APIKeyScopeSend allows sending and simulating operations.
+	APIKeyScopeSend = APIKeyScope("send")
+	// APIKeyScopeAdmin allows managing webhooks.
+	APIKeyScopeAdmin = APIKeyScope("admin")
+)
+
+func (s APIKeyScope) IsValid([]byte) error {
+	switch s {
+	case APIKeyScopeRead, APIKeyScopeSend, APIKeyScopeAdmin:
+		return nil
+	default:
+		return errors.Errorf("unknown api key scope, %q", s)
+	}
+}
+
+// HandlerPathScopes is the scopes of routes; the routes not in here need
+// APIKeyScopeRead.
+var HandlerPathScopes = map[string]APIKeyScope{
+	HandlerPathSend:     APIKeyScopeSend,
+	HandlerPathSimulate: APIKeyScopeSend,
+	HandlerPathWebhooks: APIKeyScopeAdmin,
+	HandlerPathWebhook:  APIKeyScopeAdmin,
+}
+
+type APIKey struct {
+	scopes map[APIKeyScope]struct{}
+	name   string
+	key    string
+}
+
+func NewAPIKey(name, key string, scopes []APIKeyScope) APIKey {
+	m := map[APIKeyScope]struct{}{}
+	for i := range scopes {
+		m[scopes[i]] = struct{}{}
+	}
+
+	return APIKey{name: name, key: key, scopes: m}
+}
+
+func (k APIKey) Name() string {
+	return k.name
+}
+
+func (k APIKey) Allowed(scope APIKeyScope) bool {
+	_, found := k.scopes[scope]
+
+	return found
+}
+
+// Authenticator checks the API key of request; the key comes from
+// "X-API-Key" header or bearer token of Authorization header. The request
+// without key has the public scopes.
+type Authenticator struct {
+	keys   map[[sha256.Size]byte]APIKey
+	public APIKey
+}
+
+func NewAuthenticator(public []APIKeyScope, keys []APIKey) *Authenticator {
+	m := map[[sha256.Size]byte]APIKey{}
+	for i := range keys {
+		m[sha256.Sum256([]byte(keys[i].key))] = keys[i]
+	}
+
+	return &Authenticator{keys: m, public: NewAPIKey("", "", public)}
+}
+
+// AddKey adds the key, if the same key does not exist.
+func (au *Authenticator) AddKey(k APIKey) *Authenticator {
+	h := sha256.Sum256([]byte(k.key))
+	if _, found := au.keys[h]; !found {
+		au.keys[h] = k
+	}
+
+	return au
+}
+
+// Authenticate returns the key of request; the bool is false when the
+// request has the unknown key.
+func (au *Authenticator) Authenticate(r *http.Request) (APIKey, bool) {
+	s := requestAPIKey(r)
+	if len(s) < 1 {
+		return au.public, true
+	}
+
+	k, found := au.keys[sha256.Sum256([]byte(s))]
+
+	return k, found
+}
+
+func requestAPIKey(r *http.Request) string {
+	if s := strings.TrimSpace(r.Header.Get(APIKeyHeader)); len(s) > 0 {
+		return s
+	}
+
+	if s := r.Header.Get("Authorization"); strings.HasPrefix(s, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(s, "Bearer "))
+	}
+
+	return ""
+}
+
+// APIKeyNameFromContext returns the name of the authenticated key of request.
+func APIKeyNameFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(APIKeyContextKey).(string)
+
+	return name, ok && len(name) > 0
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	stateextension "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -27,19 +29,23 @@ type BlockSessioner interface {
 
 type BlockSession struct {
 	sync.RWMutex
-	block              base.BlockMap
-	ops                []base.Operation
-	opsTree            fixedtree.Tree
-	sts                []base.State
-	st                 *Database
-	opsTreeNodes       map[string]base.OperationFixedtreeNode
-	blockModels        []mongo.WriteModel
-	operationModels    []mongo.WriteModel
-	accountModels      []mongo.WriteModel
-	balanceModels      []mongo.WriteModel
-	currencyModels     []mongo.WriteModel
-	statesValue        *sync.Map
-	balanceAddressList []string
+	block                 base.BlockMap
+	ops                   []base.Operation
+	opsTree               fixedtree.Tree
+	sts                   []base.State
+	st                    *Database
+	opsTreeNodes          map[string]base.OperationFixedtreeNode
+	blockModels           []mongo.WriteModel
+	operationModels       []mongo.WriteModel
+	accountModels         []mongo.WriteModel
+	contractAccountModels []mongo.WriteModel
+	balanceModels         []mongo.WriteModel
+	latestBalanceModels   []mongo.WriteModel
+	vestingModels         []mongo.WriteModel
+	allowanceModels       []mongo.WriteModel
+	currencyModels        []mongo.WriteModel
+	statesValue           *sync.Map
+	balanceAddressList    []string
 }
 
 func NewBlockSession(st *Database, blk base.BlockMap, ops []base.Operation, opsTree fixedtree.Tree, sts []base.State) (*BlockSession, error) {
@@ -82,6 +88,10 @@ func (bs *BlockSession) Prepare() error {
 		return err
 	}
 
+	if err := bs.prepareContractAccounts(); err != nil {
+		return err
+	}
+
 	return bs.prepareAccounts()
 }
 
@@ -119,12 +129,36 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 		}
 	}
 
+	if len(bs.contractAccountModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameContractAccount, bs.contractAccountModels); err != nil {
+			return err
+		}
+	}
+
 	if len(bs.balanceModels) > 0 {
 		if err := bs.writeModels(ctx, defaultColNameBalance, bs.balanceModels); err != nil {
 			return err
 		}
 	}
 
+	if len(bs.latestBalanceModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameLatestBalance, bs.latestBalanceModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.vestingModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameVesting, bs.vestingModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.allowanceModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameAllowance, bs.allowanceModels); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -227,6 +261,9 @@ func (bs *BlockSession) prepareAccounts() error {
 
 	var accountModels []mongo.WriteModel
 	var balanceModels []mongo.WriteModel
+	var latestBalanceModels []mongo.WriteModel
+	var vestingModels []mongo.WriteModel
+	var allowanceModels []mongo.WriteModel
 	for i := range bs.sts {
 		st := bs.sts[i]
 
@@ -238,18 +275,25 @@ func (bs *BlockSession) prepareAccounts() error {
 			}
 			accountModels = append(accountModels, j...)
 		case statecurrency.IsStateBalanceKey(st.Key()):
-			j, address, err := bs.handleBalanceState(st)
+			j, l, address, err := bs.handleBalanceState(st)
 			if err != nil {
 				return err
 			}
 			balanceModels = append(balanceModels, j...)
+			latestBalanceModels = append(latestBalanceModels, l...)
 			bs.balanceAddressList = append(bs.balanceAddressList, address)
-		//case stateextension.IsStateContractAccountKey(st.Key()):
-		//	j, err := bs.handleContractAccountState(st)
-		//	if err != nil {
-		//		return err
-		//	}
-		//	accountModels = append(accountModels, j...)
+		case statecurrency.IsStateVestingKey(st.Key()):
+			j, err := bs.handleVestingState(st)
+			if err != nil {
+				return err
+			}
+			vestingModels = append(vestingModels, j...)
+		case statecurrency.IsStateAllowanceKey(st.Key()):
+			j, err := bs.handleAllowanceState(st)
+			if err != nil {
+				return err
+			}
+			allowanceModels = append(allowanceModels, j...)
 		default:
 			continue
 		}
@@ -257,6 +301,9 @@ func (bs *BlockSession) prepareAccounts() error {
 
 	bs.accountModels = accountModels
 	bs.balanceModels = balanceModels
+	bs.latestBalanceModels = latestBalanceModels
+	bs.vestingModels = vestingModels
+	bs.allowanceModels = allowanceModels
 	return nil
 }
 
@@ -285,6 +332,31 @@ func (bs *BlockSession) prepareCurrencies() error {
 	return nil
 }
 
+func (bs *BlockSession) prepareContractAccounts() error {
+	if len(bs.sts) < 1 {
+		return nil
+	}
+
+	var contractAccountModels []mongo.WriteModel
+	for i := range bs.sts {
+		st := bs.sts[i]
+		switch {
+		case stateextension.IsStateContractAccountKey(st.Key()):
+			j, err := bs.handleContractAccountState(st)
+			if err != nil {
+				return err
+			}
+			contractAccountModels = append(contractAccountModels, j...)
+		default:
+			continue
+		}
+	}
+
+	bs.contractAccountModels = contractAccountModels
+
+	return nil
+}
+
 func (bs *BlockSession) handleAccountState(st base.State) ([]mongo.WriteModel, error) {
 	if rs, err := NewAccountValue(st); err != nil {
 		return nil, err
@@ -295,12 +367,36 @@ func (bs *BlockSession) handleAccountState(st base.State) ([]mongo.WriteModel, e
 	}
 }
 
-func (bs *BlockSession) handleBalanceState(st base.State) ([]mongo.WriteModel, string, error) {
+// handleBalanceState returns the models of the balance history and the models,
+// which replace the latest balance of address and currency.
+func (bs *BlockSession) handleBalanceState(st base.State) ([]mongo.WriteModel, []mongo.WriteModel, string, error) {
 	doc, address, err := NewBalanceDoc(st, bs.st.database.Encoder())
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
-	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, address, nil
+
+	latest := mongo.NewReplaceOneModel().
+		SetFilter(bson.M{"address": address, "currency": doc.am.Currency().String()}).
+		SetReplacement(doc).
+		SetUpsert(true)
+
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, []mongo.WriteModel{latest}, address, nil
+}
+
+func (bs *BlockSession) handleVestingState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewVestingDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleAllowanceState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewAllowanceDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
 }
 
 func (bs *BlockSession) handleContractAccountState(st base.State) ([]mongo.WriteModel, error) {
@@ -356,7 +452,7 @@ func (bs *BlockSession) writeModelsChunk(ctx context.Context, col string, models
 	opts := options.BulkWrite().SetOrdered(false)
 	if res, err := bs.st.database.Client().Collection(col).BulkWrite(ctx, models, opts); err != nil {
 		return err
-	} else if res != nil && res.InsertedCount < 1 {
+	} else if res != nil && res.InsertedCount < 1 && res.UpsertedCount < 1 && res.MatchedCount < 1 {
 		return errors.Errorf("not inserted to %s", col)
 	}
 
@@ -368,7 +464,11 @@ func (bs *BlockSession) close() error {
 	bs.operationModels = nil
 	bs.currencyModels = nil
 	bs.accountModels = nil
+	bs.contractAccountModels = nil
 	bs.balanceModels = nil
+	bs.latestBalanceModels = nil
+	bs.vestingModels = nil
+	bs.allowanceModels = nil
 
 	return bs.st.Close()
 }
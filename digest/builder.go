@@ -0,0 +1,238 @@
+package digest
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+// OperationBuilderAliases maps the former builder names to the current names,
+// so the clients of the old fact template paths keep working.
+var OperationBuilderAliases = map[string]string{
+	"create-accounts":         "create-account",
+	"key-updater":             "update-key",
+	"transfers":               "transfer",
+	"currency-register":       "register-currency",
+	"currency-policy-updater": "update-currency",
+}
+
+// OperationBuilder keeps the fact and operation hint of one operation, which
+// can be built by the builder endpoints.
+type OperationBuilder struct {
+	fact      base.Fact
+	name      string
+	factHint  hint.Hint
+	operation hint.Hint
+}
+
+func (b OperationBuilder) Name() string {
+	return b.name
+}
+
+func (b OperationBuilder) FactHint() hint.Hint {
+	return b.factHint
+}
+
+func (b OperationBuilder) OperationHint() hint.Hint {
+	return b.operation
+}
+
+// Template returns the json object of empty fact; the client fills the fields
+// and sends it to the fact builder.
+func (b OperationBuilder) Template(enc encoder.Encoder) (m map[string]interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("failed to marshal template of %q; %v", b.name, r)
+		}
+	}()
+
+	i, err := enc.Marshal(b.fact)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := util.UnmarshalJSON(i, &m); err != nil {
+		return nil, err
+	}
+
+	m["_hint"] = b.factHint.String()
+	m["hash"] = nil
+	m["token"] = ""
+
+	return m, nil
+}
+
+// NewOperationBuilders collects the buildable facts and their operations from
+// the decode details. The operation hint type is the fact hint type without
+// "-fact" suffix. The facts, which can not generate their own hash like the
+// node operations, are ignored.
+func NewOperationBuilders(operations, facts []encoder.DecodeDetail) map[string]OperationBuilder {
+	ops := map[string]hint.Hint{}
+
+	for i := range operations {
+		ht := operations[i].Hint
+
+		ops[ht.Type().String()] = ht
+	}
+
+	builders := map[string]OperationBuilder{}
+
+	for i := range facts {
+		fact, ok := facts[i].Instance.(base.Fact)
+		if !ok {
+			continue
+		}
+
+		if _, ok := fact.(common.HashGenerator); !ok {
+			continue
+		}
+
+		t := strings.TrimSuffix(facts[i].Hint.Type().String(), "-fact")
+
+		op, found := ops[t]
+		if !found {
+			continue
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(t, "mitum-currency-"), "-operation")
+
+		builders[name] = OperationBuilder{
+			fact:      fact,
+			name:      name,
+			factHint:  facts[i].Hint,
+			operation: op,
+		}
+	}
+
+	return builders
+}
+
+// BuildFact decodes the fact from the json object and sets the hash generated
+// by the fact itself. When token is empty, new token is set.
+func BuildFact(enc encoder.Encoder, networkID base.NetworkID, b []byte) (base.Fact, map[string]interface{}, error) {
+	var m map[string]interface{}
+	if err := util.UnmarshalJSON(b, &m); err != nil {
+		return nil, nil, errors.WithMessage(err, "invalid fact json")
+	}
+
+	if s, _ := m["token"].(string); len(s) < 1 {
+		m["token"] = base.Token(localtime.Now().UTC().String())
+	}
+
+	delete(m, "hash")
+
+	fact, err := decodeFact(enc, m)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g, ok := fact.(common.HashGenerator)
+	if !ok {
+		return nil, nil, errors.Errorf("fact, %T can not be built", fact)
+	}
+
+	m["hash"] = g.GenerateHash()
+
+	switch fact, err = decodeFact(enc, m); {
+	case err != nil:
+		return nil, nil, err
+	default:
+		if err := fact.IsValid(networkID); err != nil {
+			return nil, nil, err
+		}
+
+		return fact, m, nil
+	}
+}
+
+// BuildOperation makes the operation of the given hint with the fact and
+// signs. The operation hash is calculated again from the fact and signs.
+func BuildOperation(
+	enc encoder.Encoder, ht hint.Hint, fact interface{}, signs []json.RawMessage,
+) (base.Operation, error) {
+	if signs == nil {
+		signs = []json.RawMessage{}
+	}
+
+	m := map[string]interface{}{
+		"_hint": ht.String(),
+		"fact":  fact,
+		"signs": signs,
+	}
+
+	op, err := decodeOperation(enc, m)
+	if err != nil {
+		return nil, err
+	}
+
+	i, ok := op.(interface{ HashBytes() []byte })
+	if !ok {
+		return nil, errors.Errorf("operation, %T can not be built", op)
+	}
+
+	m["hash"] = valuehash.NewSHA256(i.HashBytes())
+
+	return decodeOperation(enc, m)
+}
+
+func decodeFact(enc encoder.Encoder, m map[string]interface{}) (base.Fact, error) {
+	b, err := util.MarshalJSON(m)
+	if err != nil {
+		return nil, err
+	}
+
+	hinter, err := enc.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	fact, ok := hinter.(base.Fact)
+	if !ok {
+		return nil, errors.Errorf("expected Fact, not %T", hinter)
+	}
+
+	return fact, nil
+}
+
+func decodeOperation(enc encoder.Encoder, m map[string]interface{}) (base.Operation, error) {
+	b, err := util.MarshalJSON(m)
+	if err != nil {
+		return nil, err
+	}
+
+	hinter, err := enc.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	op, ok := hinter.(base.Operation)
+	if !ok {
+		return nil, errors.Errorf("expected Operation, not %T", hinter)
+	}
+
+	return op, nil
+}
+
+func sortedOperationBuilderNames(builders map[string]OperationBuilder) []string {
+	names := make([]string, len(builders))
+
+	var i int
+
+	for k := range builders {
+		names[i] = k
+		i++
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -6,6 +6,8 @@ import (
 	"io"
 	"net/http"
 	"net/textproto"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/ProtoconNet/mitum-currency/v3/digest/util"
@@ -18,8 +20,10 @@ import (
 )
 
 var (
-	DefaultCacheExpire = time.Hour
-	SkipCacheError     = mitumutil.NewIDError("skip cache")
+	DefaultCacheExpire         = time.Hour
+	DefaultLocalMemCacheSize   = 100 * 100
+	DefaultLocalMemCacheExpire = time.Second * 10
+	SkipCacheError             = mitumutil.NewIDError("skip cache")
 )
 
 type Cache interface {
@@ -27,6 +31,14 @@ type Cache interface {
 	Set(string, []byte, time.Duration) error
 }
 
+// InvalidatableCache keeps the tags of cached responses, so the responses
+// affected by the digested block can be removed.
+type InvalidatableCache interface {
+	Cache
+	SetWithTags(string, []byte, time.Duration, []string) error
+	Invalidate(...string) error
+}
+
 func NewCacheFromURI(uri string) (Cache, error) {
 	u, err := util.ParseURL(uri, false)
 	if err != nil {
@@ -34,10 +46,11 @@ func NewCacheFromURI(uri string) (Cache, error) {
 	}
 	switch {
 	case u.Scheme == "memory":
-		// TODO set size, expire
-		return NewLocalMemCache(100*100, time.Second*10), nil
+		return NewLocalMemCacheWithQuery(u.Query())
 	case u.Scheme == "memcached":
 		return NewMemcached(u.Host)
+	case u.Scheme == "redis", u.Scheme == "rediss":
+		return NewRedisCache(u)
 	default:
 		return nil, errors.Errorf("unsupported uri of cache, %v", uri)
 	}
@@ -47,6 +60,32 @@ type LocalMemCache struct {
 	cl gcache.Cache
 }
 
+// NewLocalMemCacheWithQuery makes LocalMemCache by "size" and "expire" query.
+func NewLocalMemCacheWithQuery(q url.Values) (*LocalMemCache, error) {
+	size := DefaultLocalMemCacheSize
+	expire := DefaultLocalMemCacheExpire
+
+	if s := q.Get("size"); len(s) > 0 {
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid size, %v of memory cache", s)
+		} else if n > 0 {
+			size = int(n)
+		}
+	}
+
+	if s := q.Get("expire"); len(s) > 0 {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid expire, %v of memory cache", s)
+		} else if d > 0 {
+			expire = d
+		}
+	}
+
+	return NewLocalMemCache(size, expire), nil
+}
+
 func NewLocalMemCache(size int, expire time.Duration) *LocalMemCache {
 	cl := gcache.New(size).LRU().
 		Expiration(expire).
@@ -234,6 +273,10 @@ func (cr *CacheResponseWriter) Cache() error {
 	_, _ = buf.Write([]byte{'\r', '\n'})
 	_, _ = buf.Write(cr.buf.Bytes())
 
+	if ic, ok := cr.cache.(InvalidatableCache); ok {
+		return ic.SetWithTags(cr.Key(), buf.Bytes(), cr.Expire(), CacheTagsFromPath(cr.r.URL.Path))
+	}
+
 	return cr.cache.Set(cr.Key(), buf.Bytes(), cr.Expire())
 }
 
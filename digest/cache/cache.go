@@ -28,6 +28,8 @@ func NewCacheFromURI(uri string) (Cache, error) {
 		return NewGCacheWithQuery(u.Query())
 	case u.Scheme == "dummy":
 		return Dummy{}, nil
+	case u.Scheme == "redis", u.Scheme == "rediss":
+		return NewRedisWithURL(u)
 	default:
 		return nil, errors.Errorf("not supported uri of cache, %q", uri)
 	}
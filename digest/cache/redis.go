@@ -0,0 +1,184 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	DefaultRedisNamespace = "mitum"
+	DefaultRedisPoolSize  = 10
+	DefaultRedisTimeout   = time.Second * 3
+	// RedisURLQueries is the queries of redis url used by the caches and
+	// rate limit store, not by redis client.
+	RedisURLQueries = []string{"namespace", "size", "expire", "pool", "timeout"}
+)
+
+// NewRedisClientFromURL makes redis client from
+// "redis://[[username]:password@]host[:port][/db]"; "rediss" is for TLS. The
+// "pool" and "timeout" query are also used.
+func NewRedisClientFromURL(u *url.URL) (*redis.Client, error) {
+	if u.Scheme != "redis" && u.Scheme != "rediss" {
+		return nil, errors.Errorf("not redis url, %q", u.String())
+	}
+
+	q := u.Query()
+
+	poolSize := DefaultRedisPoolSize
+	timeout := DefaultRedisTimeout
+
+	if s := q.Get("pool"); len(s) > 0 {
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pool, %v of redis", s)
+		} else if n > 0 {
+			poolSize = int(n)
+		}
+	}
+
+	if s := q.Get("timeout"); len(s) > 0 {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timeout, %v of redis", s)
+		} else if d > 0 {
+			timeout = d
+		}
+	}
+
+	for i := range RedisURLQueries {
+		q.Del(RedisURLQueries[i])
+	}
+
+	ru := *u
+	ru.RawQuery = q.Encode()
+
+	opts, err := redis.ParseURL(ru.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid redis url")
+	}
+
+	opts.PoolSize = poolSize
+	opts.DialTimeout = timeout
+	opts.ReadTimeout = timeout
+	opts.WriteTimeout = timeout
+
+	return redis.NewClient(opts), nil
+}
+
+// Redis is the Cache by redis. The keys are prefixed by namespace, so the
+// different caches can share the same redis. The value should be []byte or
+// string.
+type Redis struct {
+	cl        *redis.Client
+	namespace string
+	expire    time.Duration
+}
+
+func NewRedisWithURL(u *url.URL) (*Redis, error) {
+	cl, err := NewRedisClientFromURL(u)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := DefaultRedisNamespace
+	expire := DefaultCacheExpire
+
+	q := u.Query()
+
+	if s := q.Get("namespace"); len(s) > 0 {
+		namespace = s
+	}
+
+	if s := q.Get("expire"); len(s) > 0 {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid expire, %v of redis", s)
+		}
+		expire = d
+	}
+
+	return &Redis{cl: cl, namespace: namespace, expire: expire}, nil
+}
+
+func (ca *Redis) Client() *redis.Client {
+	return ca.cl
+}
+
+func (ca *Redis) Namespace() string {
+	return ca.namespace
+}
+
+func (ca *Redis) Get(key interface{}) (interface{}, error) {
+	switch b, err := ca.cl.Get(context.Background(), ca.key(key)).Bytes(); {
+	case errors.Is(err, redis.Nil):
+		return nil, errors.Errorf("key not found, %v", key)
+	case err != nil:
+		return nil, err
+	default:
+		return b, nil
+	}
+}
+
+func (ca *Redis) Has(key interface{}) bool {
+	n, err := ca.cl.Exists(context.Background(), ca.key(key)).Result()
+
+	return err == nil && n > 0
+}
+
+func (ca *Redis) Set(key interface{}, v interface{}, expire time.Duration) error {
+	switch v.(type) {
+	case []byte, string:
+	default:
+		return errors.Errorf("not supported value for redis, %T", v)
+	}
+
+	if expire < 1 {
+		expire = ca.expire
+	}
+
+	return ca.cl.Set(context.Background(), ca.key(key), v, expire).Err()
+}
+
+// Purge removes all the keys under namespace.
+func (ca *Redis) Purge() error {
+	ctx := context.Background()
+
+	var cursor uint64
+
+	for {
+		keys, next, err := ca.cl.Scan(ctx, cursor, ca.namespace+":*", 1000).Result()
+		if err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			if err := ca.cl.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+
+		if cursor = next; cursor == 0 {
+			return nil
+		}
+	}
+}
+
+func (ca *Redis) Remove(key interface{}) bool {
+	n, err := ca.cl.Del(context.Background(), ca.key(key)).Result()
+
+	return err == nil && n > 0
+}
+
+func (ca *Redis) New() (Cache, error) {
+	return &Redis{cl: ca.cl, namespace: ca.namespace, expire: ca.expire}, nil
+}
+
+func (ca *Redis) key(key interface{}) string {
+	return ca.namespace + ":" + fmt.Sprint(key)
+}
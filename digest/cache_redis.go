@@ -0,0 +1,163 @@
+package digest
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ProtoconNet/mitum-currency/v3/digest/cache"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	DefaultRedisCacheNamespace = "digest"
+	DefaultRedisCacheSize      = 1 << 20
+	redisCacheInvalidateCount  = int64(512)
+)
+
+// RedisCache is the Cache by redis; the multiple API nodes can share the
+// cached responses. The responses are tagged by the request path, so they can
+// be invalidated when the new block is digested.
+//
+// Only the node, which digests the block, invalidates the responses, so the
+// API nodes should share the same redis and namespace. The API node, which
+// does not digest blocks and has its own namespace, keeps the stale responses
+// until they expire. The node behind the others caches the stale responses
+// again, but they are invalidated when it digests the same block.
+type RedisCache struct {
+	cl        *redis.Client
+	namespace string
+	size      int
+	expire    time.Duration
+}
+
+// NewRedisCache makes RedisCache from redis url. By query, "namespace" is the
+// prefix of keys, "size" is the maximum bytes of cached response and "expire"
+// limits the expire of cached response.
+func NewRedisCache(u *url.URL) (*RedisCache, error) {
+	cl, err := cache.NewRedisClientFromURL(u)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &RedisCache{
+		cl:        cl,
+		namespace: DefaultRedisCacheNamespace,
+		size:      DefaultRedisCacheSize,
+		expire:    DefaultCacheExpire,
+	}
+
+	q := u.Query()
+
+	if s := q.Get("namespace"); len(s) > 0 {
+		rc.namespace = s
+	}
+
+	if s := q.Get("size"); len(s) > 0 {
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid size, %v of redis cache", s)
+		}
+		rc.size = int(n)
+	}
+
+	if s := q.Get("expire"); len(s) > 0 {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid expire, %v of redis cache", s)
+		} else if d > 0 {
+			rc.expire = d
+		}
+	}
+
+	if err := cl.Ping(context.Background()).Err(); err != nil {
+		return nil, errors.WithMessage(err, "failed to connect redis cache")
+	}
+
+	return rc, nil
+}
+
+func (rc *RedisCache) Get(key string) ([]byte, error) {
+	switch b, err := rc.cl.Get(context.Background(), rc.key(key)).Bytes(); {
+	case errors.Is(err, redis.Nil):
+		return nil, mitumutil.ErrNotFound.Errorf("cache, %q", key)
+	case err != nil:
+		return nil, err
+	default:
+		return b, nil
+	}
+}
+
+func (rc *RedisCache) Set(key string, b []byte, expire time.Duration) error {
+	return rc.SetWithTags(key, b, expire, nil)
+}
+
+// SetWithTags stores the response; the response bigger than size is not
+// stored.
+func (rc *RedisCache) SetWithTags(key string, b []byte, expire time.Duration, tags []string) error {
+	if rc.size > 0 && len(b) > rc.size {
+		return nil
+	}
+
+	if expire < 1 || expire > rc.expire {
+		expire = rc.expire
+	}
+
+	ctx := context.Background()
+	k := rc.key(key)
+
+	_, err := rc.cl.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, k, b, expire)
+
+		// NOTE the tag keeps the keys until the longest expire.
+		for i := range tags {
+			tk := rc.tagKey(tags[i])
+
+			pipe.SAdd(ctx, tk, k)
+			pipe.PExpire(ctx, tk, rc.expire)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// Invalidate removes the responses of the tags.
+func (rc *RedisCache) Invalidate(tags ...string) error {
+	ctx := context.Background()
+
+	for i := range tags {
+		tk := rc.tagKey(tags[i])
+
+		for {
+			keys, err := rc.cl.SPopN(ctx, tk, redisCacheInvalidateCount).Result()
+			switch {
+			case errors.Is(err, redis.Nil):
+			case err != nil:
+				return err
+			}
+
+			if len(keys) < 1 {
+				break
+			}
+
+			if err := rc.cl.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rc *RedisCache) key(key string) string {
+	return rc.namespace + ":" + key
+}
+
+func (rc *RedisCache) tagKey(tag string) string {
+	return rc.namespace + ":tag:" + tag
+}
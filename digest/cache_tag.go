@@ -0,0 +1,132 @@
+package digest
+
+import (
+	"sort"
+	"strings"
+
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	stateextension "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+const (
+	CacheTagBlock      = "block"
+	CacheTagAccounts   = "accounts"
+	CacheTagCurrencies = "currency"
+)
+
+func CacheTagAccount(address string) string {
+	return "account:" + address
+}
+
+func CacheTagCurrency(cid string) string {
+	return "currency:" + cid
+}
+
+// CacheTagsFromPath returns the tags of the response by request path. The
+// responses of the particular block or operation are not changed, so they do
+// not have tags.
+func CacheTagsFromPath(path string) []string {
+	l := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case len(l[0]) < 1:
+		return []string{CacheTagBlock}
+	case l[0] == "block" && len(l) == 2 && (l[1] == "manifests" || l[1] == "operations"):
+		return []string{CacheTagBlock}
+	case l[0] == "account" && len(l) > 1:
+		return []string{CacheTagAccount(l[1])}
+	case l[0] == "accounts":
+		return []string{CacheTagAccounts}
+	case l[0] == "currency" && len(l) == 1:
+		return []string{CacheTagCurrencies}
+	case l[0] == "currency":
+		return []string{CacheTagCurrency(l[1])}
+	default:
+		return nil
+	}
+}
+
+// CacheTagsFromStates returns the tags of the responses, which are affected
+// by the states of block.
+func CacheTagsFromStates(sts []base.State) []string {
+	tags := map[string]struct{}{CacheTagBlock: {}}
+
+	for i := range sts {
+		key := sts[i].Key()
+
+		switch {
+		case statecurrency.IsStateCurrencyDesignKey(key):
+			tags[CacheTagCurrencies] = struct{}{}
+			tags[CacheTagCurrency(strings.TrimPrefix(key, statecurrency.StateKeyCurrencyDesignPrefix))] = struct{}{}
+		case statecurrency.IsStateFeeRateKey(key):
+			cid := strings.TrimPrefix(key, statecurrency.StateKeyFeeRatePrefix)
+			if j := strings.Index(cid, ":"); j > 0 {
+				cid = cid[:j]
+			}
+
+			tags[CacheTagCurrency(cid)] = struct{}{}
+		case statecurrency.IsStateAccountKey(key):
+			tags[CacheTagAccounts] = struct{}{}
+			tags[CacheTagAccount(cacheTagAddressFromStateKey(key))] = struct{}{}
+		case stateextension.IsStateContractAccountKey(key):
+			tags[CacheTagAccounts] = struct{}{}
+			tags[CacheTagAccount(cacheTagAddressFromStateKey(key))] = struct{}{}
+
+			// NOTE the owner of contract account is also changed.
+			if cs, err := stateextension.StateContractAccountValue(sts[i]); err == nil && cs.Owner() != nil {
+				tags[CacheTagAccount(cs.Owner().String())] = struct{}{}
+			}
+		case statecurrency.IsStateBalanceKey(key):
+			// NOTE the holders of currency are also changed.
+			prefix := strings.TrimSuffix(key, statecurrency.StateKeyBalanceSuffix)
+			if j := strings.Index(prefix, "-"); j > 0 {
+				tags[CacheTagCurrency(prefix[j+1:])] = struct{}{}
+			}
+
+			tags[CacheTagAccount(cacheTagAddressFromStateKey(key))] = struct{}{}
+		case statecurrency.IsStateFrozenKey(key),
+			statecurrency.IsStateVestingKey(key),
+			statecurrency.IsStateAllowanceKey(key):
+			for _, a := range cacheTagAddressesFromStateKey(key) {
+				tags[CacheTagAccount(a)] = struct{}{}
+			}
+		case statecurrency.IsStateStandingOrderKey(key):
+			tags[CacheTagAccount(cacheTagAddressFromStateKey(key))] = struct{}{}
+
+			if v, err := statecurrency.StateStandingOrderValue(sts[i]); err == nil && v.Receiver != nil {
+				tags[CacheTagAccount(v.Receiver.String())] = struct{}{}
+			}
+		}
+	}
+
+	l := make([]string, 0, len(tags))
+	for k := range tags {
+		l = append(l, k)
+	}
+
+	sort.Strings(l)
+
+	return l
+}
+
+// cacheTagAddressFromStateKey returns the address part of the state key, like
+// "<address>:account" or "<address>-<currency>:balance".
+func cacheTagAddressFromStateKey(key string) string {
+	return cacheTagAddressesFromStateKey(key)[0]
+}
+
+// cacheTagAddressesFromStateKey returns every address in the state key, like
+// "<owner>-<currency>-<spender>:allowance"; the second part is currency.
+func cacheTagAddressesFromStateKey(key string) []string {
+	if i := strings.Index(key, ":"); i > 0 {
+		key = key[:i]
+	}
+
+	l := strings.Split(key, "-")
+	if len(l) < 3 {
+		return l[:1]
+	}
+
+	return append(l[:1], l[2:]...)
+}
@@ -3,9 +3,11 @@ package config
 import (
 	"crypto/tls"
 	"net/url"
+	"strings"
 
 	"github.com/ProtoconNet/mitum-currency/v3/digest/cache"
 	"github.com/ProtoconNet/mitum-currency/v3/digest/util"
+	"github.com/pkg/errors"
 )
 
 var (
@@ -48,14 +50,17 @@ type LocalNetwork interface {
 	SetCache(string) error
 	SealCache() *url.URL
 	SetSealCache(string) error
+	CORSOrigins() []string
+	SetCORSOrigins([]string) error
 }
 
 type BaseLocalNetwork struct {
 	*BaseNodeNetwork
-	bind      *url.URL
-	certs     []tls.Certificate
-	cache     *url.URL
-	sealCache *url.URL
+	bind        *url.URL
+	certs       []tls.Certificate
+	cache       *url.URL
+	sealCache   *url.URL
+	corsOrigins []string
 }
 
 func EmptyBaseLocalNetwork() *BaseLocalNetwork {
@@ -117,3 +122,30 @@ func (no *BaseLocalNetwork) SetSealCache(s string) error {
 		return nil
 	}
 }
+
+// CORSOrigins returns the allowed origins of digest API; empty means any
+// origin.
+func (no BaseLocalNetwork) CORSOrigins() []string {
+	return no.corsOrigins
+}
+
+func (no *BaseLocalNetwork) SetCORSOrigins(origins []string) error {
+	for i := range origins {
+		if origins[i] == "*" {
+			continue
+		}
+
+		switch u, err := url.Parse(origins[i]); {
+		case err != nil:
+			return errors.Wrapf(err, "invalid cors origin, %q", origins[i])
+		case u.Scheme != "http" && u.Scheme != "https",
+			len(u.Host) < 1,
+			len(strings.Trim(u.Path, "/")) > 0:
+			return errors.Errorf("invalid cors origin, %q; should be like \"https://example.com\"", origins[i])
+		}
+	}
+
+	no.corsOrigins = origins
+
+	return nil
+}
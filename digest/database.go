@@ -3,6 +3,7 @@ package digest
 import (
 	"context"
 	"fmt"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	mongodbstorage "github.com/ProtoconNet/mitum-currency/v3/digest/mongodb"
 	"github.com/ProtoconNet/mitum-currency/v3/digest/util"
 	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
@@ -12,6 +13,7 @@ import (
 	isaacdatabase "github.com/ProtoconNet/mitum2/isaac/database"
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/localtime"
 	"github.com/ProtoconNet/mitum2/util/logging"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -24,21 +26,34 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 var maxLimit int64 = 50
 
 var (
-	defaultColNameAccount   = "digest_ac"
-	defaultColNameBalance   = "digest_bl"
-	defaultColNameCurrency  = "digest_cr"
-	defaultColNameOperation = "digest_op"
-	defaultColNameBlock     = "digest_bm"
+	defaultColNameAccount         = "digest_ac"
+	defaultColNameContractAccount = "digest_ca"
+	defaultColNameBalance         = "digest_bl"
+	defaultColNameVesting         = "digest_vs"
+	defaultColNameAllowance       = "digest_al"
+	defaultColNameCurrency        = "digest_cr"
+	defaultColNameOperation       = "digest_op"
+	defaultColNameBlock           = "digest_bm"
+	// NOTE defaultColNameLatestBalance keeps only the latest balance of each
+	// address and currency.
+	defaultColNameLatestBalance = "digest_bl_latest"
+	// NOTE webhooks are not digested from blocks, so they are not cleaned.
+	defaultColNameWebhook = "digest_wh"
 )
 
 var AllCollections = []string{
 	defaultColNameAccount,
+	defaultColNameContractAccount,
 	defaultColNameBalance,
+	defaultColNameLatestBalance,
+	defaultColNameVesting,
+	defaultColNameAllowance,
 	defaultColNameCurrency,
 	defaultColNameOperation,
 	defaultColNameBlock,
@@ -140,6 +155,10 @@ func (st *Database) Initialize() error {
 			return err
 		}
 
+		if err := st.backfillLatestBalances(context.Background()); err != nil {
+			return errors.Wrap(err, "failed to backfill latest balances")
+		}
+
 		// if err := st.cleanByHeight(context.Background(), h+1); err != nil {
 		// 	return err
 		// }
@@ -210,7 +229,11 @@ func (st *Database) Clean() error {
 func (st *Database) clean(ctx context.Context) error {
 	for _, col := range []string{
 		defaultColNameAccount,
+		defaultColNameContractAccount,
 		defaultColNameBalance,
+		defaultColNameLatestBalance,
+		defaultColNameVesting,
+		defaultColNameAllowance,
 		defaultColNameCurrency,
 		defaultColNameOperation,
 		defaultColNameBlock,
@@ -247,12 +270,25 @@ func (st *Database) cleanByHeight(ctx context.Context, height base.Height) error
 		return st.clean(ctx)
 	}
 
+	// NOTE the latest balances over height are removed and restored from the
+	// balance history under height.
+	addresses, err := st.database.Client().Collection(defaultColNameLatestBalance).Distinct(
+		ctx, "address", bson.M{"height": bson.M{"$gte": height}},
+	)
+	if err != nil {
+		return err
+	}
+
 	opts := options.BulkWrite().SetOrdered(true)
 	removeByHeight := mongo.NewDeleteManyModel().SetFilter(bson.M{"height": bson.M{"$gte": height}})
 
 	for _, col := range []string{
 		defaultColNameAccount,
+		defaultColNameContractAccount,
 		defaultColNameBalance,
+		defaultColNameLatestBalance,
+		defaultColNameVesting,
+		defaultColNameAllowance,
 		defaultColNameCurrency,
 		defaultColNameOperation,
 		defaultColNameBlock,
@@ -269,9 +305,106 @@ func (st *Database) cleanByHeight(ctx context.Context, height base.Height) error
 		st.Log().Debug().Str("collection", col).Interface("result", res).Msg("clean collection by height")
 	}
 
+	if len(addresses) > 0 {
+		if err := st.mergeLatestBalances(ctx, bson.M{"address": bson.M{"$in": addresses}}); err != nil {
+			return err
+		}
+	}
+
 	return st.setLastBlock(height - 1)
 }
 
+// backfillLatestBalances fills the latest balances from the balance history,
+// when the latest balances are empty, and sets the missing amount_key of the
+// balances digested by the old versions.
+func (st *Database) backfillLatestBalances(ctx context.Context) error {
+	switch n, err := st.database.Client().Count(ctx, defaultColNameLatestBalance, bson.M{}); {
+	case err != nil:
+		return err
+	case n < 1:
+		if err := st.mergeLatestBalances(ctx, bson.M{}); err != nil {
+			return err
+		}
+
+		st.Log().Debug().Msg("latest balances filled")
+	}
+
+	cursor, err := st.database.Client().Collection(defaultColNameLatestBalance).Find(
+		ctx, bson.M{"amount_key": bson.M{"$exists": false}},
+		options.Find().SetProjection(bson.M{"_id": 1, "amount": 1}),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cursor.Close(context.Background())
+	}()
+
+	var models []mongo.WriteModel
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID     primitive.ObjectID `bson:"_id"`
+			Amount string             `bson:"amount"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+
+		big, err := common.NewBigFromString(doc.Amount)
+		if err != nil {
+			return err
+		}
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": doc.ID}).
+			SetUpdate(bson.M{"$set": bson.M{"amount_key": balanceSortKey(big)}}),
+		)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	if len(models) < 1 {
+		return nil
+	}
+
+	return st.database.Client().Bulk(ctx, defaultColNameLatestBalance, models, false)
+}
+
+// mergeLatestBalances merges the last balance of each address and currency in
+// the balance history, which are matched by filter, into the latest balances.
+func (st *Database) mergeLatestBalances(ctx context.Context, filter bson.M) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "address", Value: 1}, {Key: "currency", Value: 1}, {Key: "height", Value: -1},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{"address": "$address", "currency": "$currency"},
+			"doc": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$doc"}}},
+		{{Key: "$unset", Value: "_id"}},
+		{{Key: "$merge", Value: bson.M{
+			"into":           defaultColNameLatestBalance,
+			"on":             bson.A{"address", "currency"},
+			"whenMatched":    "replace",
+			"whenNotMatched": "insert",
+		}}},
+	}
+
+	cursor, err := st.database.Client().Collection(defaultColNameBalance).Aggregate(
+		ctx, pipeline, options.Aggregate().SetAllowDiskUse(true),
+	)
+	if err != nil {
+		return err
+	}
+
+	return cursor.Close(ctx)
+}
+
 /*
 func (st *Database) Manifest(h mitumutil.Hash) (base.Manifest, bool, error) {
 	return st.mitum.Manifest(h)
@@ -334,15 +467,18 @@ func (st *Database) Manifests(
 // * reverse: order by height; if true, higher height will be returned first.
 // *  offset: returns from next of offset, usually it is combination of
 // "<height>,<fact>".
+// *    memo: if not empty, returns the operations, which have the item with
+// the given memo for the Address.
 func (st *Database) OperationsByAddress(
 	address base.Address,
 	load,
 	reverse bool,
 	offset string,
+	memo string,
 	limit int64,
 	callback func(mitumutil.Hash /* fact hash */, OperationValue) (bool, error),
 ) error {
-	filter, err := buildOperationsFilterByAddress(address, offset, reverse)
+	filter, err := buildOperationsFilterByAddress(address, offset, memo, reverse)
 	if err != nil {
 		return err
 	}
@@ -519,14 +655,16 @@ func (st *Database) Account(a base.Address) (AccountValue, bool /* exists */, er
 			SetHeight(lastHeight)
 	}
 	// NOTE load contract account status
-	//switch status, lastHeight, err := st.contractAccountStatus(a); {
-	//case err != nil:
-	//	rs = rs.SetContractAccountStatus(types.NewContractAccountStatus(nil, false)).
-	//		SetHeight(lastHeight)
-	//default:
-	//	rs = rs.SetContractAccountStatus(status).
-	//		SetHeight(lastHeight)
-	//}
+	switch status, lastHeight, err := st.contractAccountStatus(a); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetContractAccountStatus(status)
+		if lastHeight > rs.Height() {
+			rs = rs.SetHeight(lastHeight)
+		}
+	}
 
 	return rs, true, nil
 }
@@ -605,12 +743,87 @@ end:
 	return nil
 }
 
+// AccountsByBalance returns the latest balance states of the given currency
+// by the descending order of amount. The balances come from the latest
+// balances, so the pages follow the current balances.
+// *  offset: returns from next of offset, usually it is "<height>,<address>".
 func (st *Database) AccountsByBalance(
-	offsetHeight base.Height,
+	cid string,
 	offsetAddress string,
 	limit int64,
-	callback func(AccountValue) (bool, error)) error {
-	return nil
+	callback func(base.State) (bool, error),
+) error {
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	filter := bson.M{"currency": cid, "amount": bson.M{"$ne": "0"}}
+
+	if len(offsetAddress) > 0 {
+		var key string
+		if err := st.database.Client().GetByFilter(
+			defaultColNameLatestBalance,
+			bson.D{
+				{Key: "address", Value: offsetAddress},
+				{Key: "currency", Value: cid},
+			},
+			func(res *mongo.SingleResult) error {
+				var doc struct {
+					K string `bson:"amount_key"`
+				}
+				if err := res.Decode(&doc); err != nil {
+					return err
+				}
+				key = doc.K
+
+				return nil
+			},
+		); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return mitumutil.ErrNotFound.Errorf("balance of offset address, %v", offsetAddress)
+			}
+
+			return err
+		}
+
+		filter["$or"] = bson.A{
+			bson.M{"amount_key": bson.M{"$lt": key}},
+			bson.M{"amount_key": key, "address": bson.M{"$gt": offsetAddress}},
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	cursor, err := st.database.Client().Collection(defaultColNameLatestBalance).Find(
+		ctx,
+		filter,
+		options.Find().
+			SetSort(bson.D{{Key: "amount_key", Value: -1}, {Key: "address", Value: 1}}).
+			SetLimit(limit),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cursor.Close(context.Background())
+	}()
+
+	for cursor.Next(ctx) {
+		sta, err := LoadBalance(cursor.Decode, st.database.Encoders())
+		if err != nil {
+			return err
+		}
+
+		switch keep, err := callback(sta); {
+		case err != nil:
+			return err
+		case !keep:
+			return nil
+		}
+	}
+
+	return cursor.Err()
 }
 
 func (st *Database) balance(a base.Address) ([]types.Amount, base.Height, error) {
@@ -673,18 +886,113 @@ func (st *Database) balance(a base.Address) ([]types.Amount, base.Height, error)
 	return ams, lastHeight, nil
 }
 
+// Vestings returns the latest vesting states of the given address by the
+// order of currency and sender.
+func (st *Database) Vestings(a base.Address, callback func(base.State) (bool, error)) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"address": a.String()}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "currency", Value: 1}, {Key: "sender", Value: 1}, {Key: "height", Value: -1},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{"currency": "$currency", "sender": "$sender"},
+			"doc": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$doc"}}},
+		{{Key: "$sort", Value: bson.D{{Key: "currency", Value: 1}, {Key: "sender", Value: 1}}}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	cursor, err := st.database.Client().Collection(defaultColNameVesting).Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cursor.Close(context.Background())
+	}()
+
+	for cursor.Next(ctx) {
+		sta, err := LoadVesting(cursor.Decode, st.database.Encoders())
+		if err != nil {
+			return err
+		}
+
+		switch keep, err := callback(sta); {
+		case err != nil:
+			return err
+		case !keep:
+			return nil
+		}
+	}
+
+	return cursor.Err()
+}
+
+// Allowances returns the latest allowance states approved by owner by the
+// order of spender and currency. If spender is not nil, only the allowances of
+// spender are returned.
+func (st *Database) Allowances(
+	owner, spender base.Address, callback func(base.State) (bool, error),
+) error {
+	match := bson.M{"owner": owner.String()}
+	if spender != nil {
+		match["spender"] = spender.String()
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "spender", Value: 1}, {Key: "currency", Value: 1}, {Key: "height", Value: -1},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{"spender": "$spender", "currency": "$currency"},
+			"doc": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$doc"}}},
+		{{Key: "$sort", Value: bson.D{{Key: "spender", Value: 1}, {Key: "currency", Value: 1}}}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	cursor, err := st.database.Client().Collection(defaultColNameAllowance).Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cursor.Close(context.Background())
+	}()
+
+	for cursor.Next(ctx) {
+		sta, err := LoadAllowance(cursor.Decode, st.database.Encoders())
+		if err != nil {
+			return err
+		}
+
+		switch keep, err := callback(sta); {
+		case err != nil:
+			return err
+		case !keep:
+			return nil
+		}
+	}
+
+	return cursor.Err()
+}
+
 func (st *Database) contractAccountStatus(a base.Address) (types.ContractAccountStatus, base.Height, error) {
 	lastHeight := base.NilHeight
 
-	filter := util.NewBSONFilter("address", a)
-	filter.Add("contract", true)
+	filter := util.NewBSONFilter("address", a.String())
 
 	opt := options.FindOne().SetSort(
 		util.NewBSONFilter("height", -1).D(),
 	)
 	var sta base.State
 	if err := st.database.Client().GetByFilter(
-		defaultColNameAccount,
+		defaultColNameContractAccount,
 		filter.D(),
 		func(res *mongo.SingleResult) error {
 			i, err := LoadContractAccountStatus(res.Decode, st.database.Encoders())
@@ -714,6 +1022,146 @@ func (st *Database) contractAccountStatus(a base.Address) (types.ContractAccount
 	}
 }
 
+// ContractAccountStatus returns the latest contract account state of the given
+// address.
+func (st *Database) ContractAccountStatus(a base.Address) (base.State, bool /* exists */, error) {
+	var sta base.State
+	if err := st.database.Client().GetByFilter(
+		defaultColNameContractAccount,
+		util.NewBSONFilter("address", a.String()).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadContractAccountStatus(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			sta = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return sta, true, nil
+}
+
+// ContractAccountsByOwner returns the latest contract account states, which
+// are currently owned by the given owner, by the order of address.
+// *  offset: returns from next of offset, usually it is "<height>,<address>".
+func (st *Database) ContractAccountsByOwner(
+	owner base.Address,
+	offsetHeight base.Height,
+	offsetAddress string,
+	limit int64,
+	callback func(base.State) (bool, error),
+) error {
+	if offsetHeight <= base.NilHeight {
+		return errors.Errorf("offset height should be over nil height")
+	}
+
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	// NOTE the owner can be changed, so the addresses ever owned by owner are
+	// filtered by their latest state.
+	addresses, err := st.database.Client().Collection(defaultColNameContractAccount).Distinct(
+		ctx,
+		"address",
+		bson.M{"owner": owner.String(), "height": bson.M{"$lte": offsetHeight}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(addresses) < 1 {
+		return nil
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"address": bson.M{"$in": addresses}, "height": bson.M{"$lte": offsetHeight}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "address", Value: 1}, {Key: "height", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$address", "doc": bson.M{"$first": "$$ROOT"}}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$doc"}}},
+		{{Key: "$match", Value: bson.M{"owner": owner.String()}}},
+	}
+
+	if len(offsetAddress) > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"address": bson.M{"$gt": offsetAddress}}}})
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "address", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: limit}},
+	)
+
+	cursor, err := st.database.Client().Collection(defaultColNameContractAccount).Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cursor.Close(context.Background())
+	}()
+
+	for cursor.Next(ctx) {
+		sta, err := LoadContractAccountStatus(cursor.Decode, st.database.Encoders())
+		if err != nil {
+			return err
+		}
+
+		switch keep, err := callback(sta); {
+		case err != nil:
+			return err
+		case !keep:
+			return nil
+		}
+	}
+
+	return cursor.Err()
+}
+
+// ContractAccountOwnerHistory returns the contract account states of the
+// given address at which its owner was changed, from the oldest.
+func (st *Database) ContractAccountOwnerHistory(
+	a base.Address,
+	callback func(base.State) (bool, error),
+) error {
+	var lastOwner base.Address
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameContractAccount,
+		util.NewBSONFilter("address", a.String()).D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := LoadContractAccountStatus(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			cas, err := extension.StateContractAccountValue(sta)
+			if err != nil {
+				return false, err
+			}
+
+			if lastOwner != nil && lastOwner.Equal(cas.Owner()) {
+				return true, nil
+			}
+			lastOwner = cas.Owner()
+
+			return callback(sta)
+		},
+		options.Find().SetSort(util.NewBSONFilter("height", 1).D()),
+	)
+}
+
 func (st *Database) currencies() ([]string, error) {
 	var cids []string
 
@@ -1093,8 +1541,11 @@ func buildOffset(height base.Height, index uint64) string {
 	return fmt.Sprintf("%d,%d", height, index)
 }
 
-func buildOperationsFilterByAddress(address base.Address, offset string, reverse bool) (bson.M, error) {
+func buildOperationsFilterByAddress(address base.Address, offset, memo string, reverse bool) (bson.M, error) {
 	filter := bson.M{"addresses": bson.M{"$in": []string{address.String()}}}
+	if len(memo) > 0 {
+		filter["memos"] = bson.M{"$elemMatch": bson.M{"address": address.String(), "memo": memo}}
+	}
 	if len(offset) > 0 {
 		height, index, err := parseOffset(offset)
 		if err != nil {
@@ -1164,6 +1615,125 @@ func loadHeightDoc(decoder func(interface{}) error) (base.Height, error) {
 	return h.H, nil
 }
 
+// Webhooks returns all the webhooks.
+func (st *Database) Webhooks() ([]Webhook, error) {
+	var whs []Webhook
+
+	if err := st.database.Client().Find(
+		context.Background(),
+		defaultColNameWebhook,
+		bson.D{},
+		func(cursor *mongo.Cursor) (bool, error) {
+			var wh Webhook
+			if err := cursor.Decode(&wh); err != nil {
+				return false, err
+			}
+
+			whs = append(whs, wh)
+
+			return true, nil
+		},
+		options.Find().SetSort(util.NewBSONFilter("created_at", 1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return whs, nil
+}
+
+func (st *Database) Webhook(id string) (Webhook, bool, error) {
+	var wh Webhook
+
+	switch err := st.database.Client().GetByID(
+		defaultColNameWebhook,
+		id,
+		func(res *mongo.SingleResult) error {
+			return res.Decode(&wh)
+		},
+	); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return wh, false, nil
+	case err != nil:
+		return wh, false, err
+	default:
+		return wh, true, nil
+	}
+}
+
+func (st *Database) SetWebhook(wh Webhook) error {
+	if st.readonly {
+		return errors.Errorf("readonly mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := st.database.Client().Collection(defaultColNameWebhook).ReplaceOne(
+		ctx,
+		util.NewBSONFilter("_id", wh.ID).D(),
+		wh,
+		options.Replace().SetUpsert(true),
+	)
+
+	return err
+}
+
+// SetWebhookDelivered keeps the highest delivered height of webhook.
+func (st *Database) SetWebhookDelivered(id string, height base.Height) error {
+	if st.readonly {
+		return errors.Errorf("readonly mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := st.database.Client().Collection(defaultColNameWebhook).UpdateOne(
+		ctx,
+		util.NewBSONFilter("_id", id).D(),
+		bson.M{
+			"$max": bson.M{"status.delivered_height": height},
+			"$set": bson.M{"status.delivered_at": localtime.Now().UTC()},
+		},
+	)
+
+	return err
+}
+
+// SetWebhookFailure counts the failed delivery of webhook and keeps the last
+// one.
+func (st *Database) SetWebhookFailure(id string, failure WebhookFailure) error {
+	if st.readonly {
+		return errors.Errorf("readonly mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := st.database.Client().Collection(defaultColNameWebhook).UpdateOne(
+		ctx,
+		util.NewBSONFilter("_id", id).D(),
+		bson.M{
+			"$inc": bson.M{"status.failures": 1},
+			"$set": bson.M{"status.last_failure": failure},
+		},
+	)
+
+	return err
+}
+
+func (st *Database) RemoveWebhook(id string) (bool, error) {
+	if st.readonly {
+		return false, errors.Errorf("readonly mode")
+	}
+
+	res, err := st.database.Client().Delete(defaultColNameWebhook, util.NewBSONFilter("_id", id).D())
+	if err != nil {
+		return false, err
+	}
+
+	return res.DeletedCount > 0, nil
+}
+
 type briefAccountDoc struct {
 	ID      primitive.ObjectID `bson:"_id"`
 	Address string             `bson:"address"`
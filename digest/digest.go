@@ -55,6 +55,10 @@ type Digester struct {
 	localfsRoot string
 	blockChan   chan base.BlockMap
 	errChan     chan error
+	streamer    *Streamer
+	webhooks    *WebhookDispatcher
+	cache       InvalidatableCache
+	pendings    *PendingOperations
 }
 
 func NewDigester(st *Database, root string, errChan chan error) *Digester {
@@ -66,6 +70,7 @@ func NewDigester(st *Database, root string, errChan chan error) *Digester {
 		localfsRoot: root,
 		blockChan:   make(chan base.BlockMap, 100),
 		errChan:     errChan,
+		streamer:    NewStreamer(),
 	}
 
 	di.ContextDaemon = util.NewContextDaemon(di.start)
@@ -74,6 +79,10 @@ func NewDigester(st *Database, root string, errChan chan error) *Digester {
 }
 
 func (di *Digester) start(ctx context.Context) error {
+	if di.webhooks != nil {
+		di.webhooks.Start(ctx)
+	}
+
 	errch := func(err DigestError) {
 		if di.errChan == nil {
 			return
@@ -171,7 +180,66 @@ func (di *Digester) digest(ctx context.Context, blk base.BlockMap) error {
 		return err
 	}
 
-	return di.database.SetLastBlock(blk.Manifest().Height())
+	if err := di.database.SetLastBlock(blk.Manifest().Height()); err != nil {
+		return err
+	}
+
+	if di.cache != nil {
+		if err := di.cache.Invalidate(CacheTagsFromStates(sts)...); err != nil {
+			di.Log().Error().Err(err).Int64("block", blk.Manifest().Height().Int64()).Msg("failed to invalidate cache")
+		}
+	}
+
+	if di.pendings != nil {
+		di.pendings.Prune(blk.Manifest().Height())
+	}
+
+	switch events, err := NewStreamEvents(blk, ops, opstree, sts); {
+	case err != nil:
+		di.Log().Error().Err(err).Int64("block", blk.Manifest().Height().Int64()).Msg("failed to make stream events")
+	default:
+		di.streamer.Publish(events)
+
+		if di.webhooks != nil {
+			di.webhooks.Dispatch(events)
+		}
+	}
+
+	return nil
+}
+
+func (di *Digester) SetWebhookDispatcher(wd *WebhookDispatcher) *Digester {
+	di.webhooks = wd
+
+	return di
+}
+
+// SetPendingOperations sets the pending operations of API, which are pruned by
+// the digested height.
+func (di *Digester) SetPendingOperations(po *PendingOperations) *Digester {
+	di.Lock()
+	defer di.Unlock()
+
+	di.pendings = po
+
+	return di
+}
+
+// SetCache sets the cache of API; the cached responses affected by the
+// digested block are invalidated.
+func (di *Digester) SetCache(c InvalidatableCache) *Digester {
+	di.Lock()
+	defer di.Unlock()
+
+	di.cache = c
+
+	return di
+}
+
+// Streamer returns the Streamer, which receives the events of the digested
+// blocks.
+func (di *Digester) Streamer() *Streamer {
+	return di.streamer
 }
 
 func DigestBlock(
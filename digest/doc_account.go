@@ -1,6 +1,9 @@
 package digest
 
 import (
+	"fmt"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	mongodbstorage "github.com/ProtoconNet/mitum-currency/v3/digest/mongodb"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
 	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
@@ -92,6 +95,91 @@ func (doc BalanceDoc) MarshalBSON() ([]byte, error) {
 	m["currency"] = doc.am.Currency().String()
 	m["height"] = doc.st.Height()
 	m["amount"] = doc.am.Big().String()
+	m["amount_key"] = balanceSortKey(doc.am.Big())
+
+	return bsonenc.Marshal(m)
+}
+
+// balanceSortKey returns the amount as string, which can be sorted in
+// lexicographical order; the digits are prefixed by it's length.
+func balanceSortKey(big common.Big) string {
+	s := big.String()
+
+	return fmt.Sprintf("%03d%s", len(s), s)
+}
+
+type VestingDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	v  currency.VestingStateValue
+}
+
+func NewVestingDoc(st base.State, enc encoder.Encoder) (VestingDoc, error) {
+	v, err := currency.StateVestingValue(st)
+	if err != nil {
+		return VestingDoc{}, errors.Wrap(err, "VestingDoc needs Vesting state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return VestingDoc{}, err
+	}
+
+	return VestingDoc{
+		BaseDoc: b,
+		st:      st,
+		v:       v,
+	}, nil
+}
+
+func (doc VestingDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["address"] = doc.v.Receiver.String()
+	m["sender"] = doc.v.Sender.String()
+	m["currency"] = doc.v.Amount.Currency().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type AllowanceDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	v  currency.AllowanceStateValue
+}
+
+func NewAllowanceDoc(st base.State, enc encoder.Encoder) (AllowanceDoc, error) {
+	v, err := currency.StateAllowanceValue(st)
+	if err != nil {
+		return AllowanceDoc{}, errors.Wrap(err, "AllowanceDoc needs Allowance state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return AllowanceDoc{}, err
+	}
+
+	return AllowanceDoc{
+		BaseDoc: b,
+		st:      st,
+		v:       v,
+	}, nil
+}
+
+func (doc AllowanceDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["owner"] = doc.v.Owner.String()
+	m["spender"] = doc.v.Spender.String()
+	m["currency"] = doc.v.Amount.Currency().String()
+	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
 }
@@ -1,7 +1,9 @@
 package digest
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"go.mongodb.org/mongo-driver/bson"
 	"time"
 
 	mongodbstorage "github.com/ProtoconNet/mitum-currency/v3/digest/mongodb"
@@ -15,6 +17,8 @@ type OperationDoc struct {
 	va        OperationValue
 	op        base.Operation
 	addresses []string
+	memos     []bson.M
+	burned    bson.M
 	height    base.Height
 }
 
@@ -39,6 +43,11 @@ func NewOperationDoc(
 		}
 	}
 
+	memos, err := operationMemos(op.Fact())
+	if err != nil {
+		return OperationDoc{}, err
+	}
+
 	va := NewOperationValue(op, height, confirmedAt, inState, reason, index)
 	b, err := mongodbstorage.NewBaseDoc(nil, va, enc)
 	if err != nil {
@@ -50,6 +59,8 @@ func NewOperationDoc(
 		va:        va,
 		op:        op,
 		addresses: addresses,
+		memos:     memos,
+		burned:    operationBurned(op.Fact()),
 		height:    height,
 	}, nil
 }
@@ -61,9 +72,57 @@ func (doc OperationDoc) MarshalBSON() ([]byte, error) {
 	}
 
 	m["addresses"] = doc.addresses
+	if len(doc.memos) > 0 {
+		m["memos"] = doc.memos
+	}
+	if doc.burned != nil {
+		m["burned"] = doc.burned
+	}
 	m["fact"] = doc.op.Fact().Hash()
 	m["height"] = doc.height
 	m["index"] = doc.va.index
 
 	return bsonenc.Marshal(m)
 }
+
+// operationMemos collects the memos of items with their receiver address.
+func operationMemos(fact base.Fact) ([]bson.M, error) {
+	var memos []bson.M
+
+	switch t := fact.(type) {
+	case currency.TransferFact:
+		items := t.Items()
+		for i := range items {
+			if memo := items[i].Memo(); len(memo) > 0 {
+				memos = append(memos, bson.M{"address": items[i].Receiver().String(), "memo": memo})
+			}
+		}
+	case currency.CreateAccountFact:
+		items := t.Items()
+		for i := range items {
+			memo := items[i].Memo()
+			if len(memo) < 1 {
+				continue
+			}
+
+			a, err := items[i].Address()
+			if err != nil {
+				return nil, err
+			}
+			memos = append(memos, bson.M{"address": a.String(), "memo": memo})
+		}
+	}
+
+	return memos, nil
+}
+
+// operationBurned returns the burned amount of Burn to find burn operations by
+// currency.
+func operationBurned(fact base.Fact) bson.M {
+	t, ok := fact.(currency.BurnFact)
+	if !ok {
+		return nil
+	}
+
+	return bson.M{"currency": t.Amount().Currency().String(), "amount": t.Amount().Big().String()}
+}
@@ -0,0 +1,139 @@
+package digest
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/batch"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// feeAmountsItem is the AmountsItem for the facts, which have no items, but
+// are charged the fee like the transfer of the amounts.
+type feeAmountsItem []types.Amount
+
+func (ams feeAmountsItem) Amounts() []types.Amount {
+	return ams
+}
+
+// feeCurrencyFact is the fact, which can pay the fee in the other currency.
+type feeCurrencyFact interface {
+	FeeCurrency() types.CurrencyID
+}
+
+// FeeEstimate is the amount of a currency, which is needed to process the fact.
+// Required includes Fee like the required map of the operation processors.
+type FeeEstimate struct {
+	Currency types.CurrencyID `json:"currency"`
+	Required common.Big       `json:"required"`
+	Fee      common.Big       `json:"fee"`
+}
+
+// CalculateAmountFee estimates the fee of transferring amount.
+func CalculateAmountFee(getStateFunc base.GetStateFunc, amount types.Amount) (FeeEstimate, error) {
+	_, required, err := currency.CalculateItemsFee(getStateFunc, []currency.AmountsItem{feeAmountsItem{amount}})
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	rq := required[amount.Currency()]
+
+	return FeeEstimate{Currency: amount.Currency(), Required: rq[0], Fee: rq[1]}, nil
+}
+
+// CalculateFactFee estimates the required amounts and fees of fact by
+// currency. The facts, whose fee depends on the other states like the
+// cancellation of standing order, are not supported.
+func CalculateFactFee(getStateFunc base.GetStateFunc, fact base.Fact) ([]FeeEstimate, error) {
+	required, err := factRequired(getStateFunc, fact)
+	if err != nil {
+		return nil, err
+	}
+
+	fes := make([]FeeEstimate, len(required))
+
+	var i int
+	for cid := range required {
+		fes[i] = FeeEstimate{Currency: cid, Required: required[cid][0], Fee: required[cid][1]}
+		i++
+	}
+
+	sort.Slice(fes, func(i, j int) bool {
+		return fes[i].Currency < fes[j].Currency
+	})
+
+	return fes, nil
+}
+
+func factRequired(
+	getStateFunc base.GetStateFunc, fact base.Fact,
+) (map[types.CurrencyID][2]common.Big, error) {
+	var items []currency.AmountsItem
+
+	switch t := fact.(type) {
+	case batch.BatchFact:
+		required := map[types.CurrencyID][2]common.Big{}
+
+		for _, it := range t.Items() {
+			rq, err := factRequired(getStateFunc, it)
+			if err != nil {
+				return nil, err
+			}
+
+			for cid := range rq {
+				if k, found := required[cid]; found {
+					rq[cid] = [2]common.Big{k[0].Add(rq[cid][0]), k[1].Add(rq[cid][1])}
+				}
+
+				required[cid] = rq[cid]
+			}
+		}
+
+		return required, nil
+	case currency.TransferFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case currency.CreateAccountFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case extension.CreateContractAccountFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case extension.WithdrawFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case currency.UpdateKeyFact:
+		items = []currency.AmountsItem{feeAmountsItem{types.NewZeroAmount(t.Currency())}}
+	case extension.UpdateOperatorFact:
+		items = []currency.AmountsItem{feeAmountsItem{types.NewZeroAmount(t.Currency())}}
+	case extension.UpdateContractAccountOwnerFact:
+		items = []currency.AmountsItem{feeAmountsItem{types.NewZeroAmount(t.Currency())}}
+	case currency.ApproveFact:
+		items = []currency.AmountsItem{feeAmountsItem{types.NewZeroAmount(t.Amount().Currency())}}
+	case currency.RegisterStandingOrderFact:
+		items = []currency.AmountsItem{feeAmountsItem{types.NewZeroAmount(t.Amount().Currency())}}
+	case currency.ClaimVestingFact:
+		items = []currency.AmountsItem{feeAmountsItem{types.NewZeroAmount(t.Currency())}}
+	case currency.AmountsItem:
+		items = []currency.AmountsItem{t}
+	default:
+		return nil, errors.Errorf("fee estimation not supported, %T", fact)
+	}
+
+	var feeCurrency types.CurrencyID
+	if i, ok := fact.(feeCurrencyFact); ok {
+		feeCurrency = i.FeeCurrency()
+	}
+
+	_, required, err := currency.CalculateItemsFeeWithFeeCurrency(getStateFunc, items, feeCurrency)
+
+	return required, err
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	"golang.org/x/exp/slices"
 	"golang.org/x/sync/singleflight"
 )
 
@@ -31,6 +32,9 @@ var (
 	HandlerPathNodeInfo                   = `/`
 	HandlerPathCurrencies                 = `/currency`
 	HandlerPathCurrency                   = `/currency/{currencyid:.*}`
+	HandlerPathCurrencyHolders            = `/currency/{currencyid:.*}/holders`
+	HandlerPathCurrencyBurns              = `/currency/{currencyid:.*}/burns`
+	HandlerPathCurrencyFee                = `/currency/{currencyid:.*}/fee`
 	HandlerPathManifests                  = `/block/manifests`
 	HandlerPathOperations                 = `/block/operations`
 	HandlerPathOperation                  = `/block/operation/{hash:(?i)[0-9a-z][0-9a-z]+}`
@@ -39,14 +43,24 @@ var (
 	HandlerPathOperationsByHeight         = `/block/{height:[0-9]+}/operations`
 	HandlerPathManifestByHeight           = `/block/{height:[0-9]+}/manifest`
 	HandlerPathManifestByHash             = `/block/{hash:(?i)[0-9a-z][0-9a-z]+}/manifest`
-	HandlerPathAccount                    = `/account/{address:(?i)` + base.REStringAddressString + `}`            // revive:disable-line:line-length-limit
-	HandlerPathAccountOperations          = `/account/{address:(?i)` + base.REStringAddressString + `}/operations` // revive:disable-line:line-length-limit
+	HandlerPathAccount                    = `/account/{address:(?i)` + base.REStringAddressString + `}`                 // revive:disable-line:line-length-limit
+	HandlerPathAccountOperations          = `/account/{address:(?i)` + base.REStringAddressString + `}/operations`      // revive:disable-line:line-length-limit
+	HandlerPathAccountVesting             = `/account/{address:(?i)` + base.REStringAddressString + `}/vesting`         // revive:disable-line:line-length-limit
+	HandlerPathAccountAllowances          = `/account/{address:(?i)` + base.REStringAddressString + `}/allowances`      // revive:disable-line:line-length-limit
+	HandlerPathContractAccount            = `/account/{address:(?i)` + base.REStringAddressString + `}/contract`        // revive:disable-line:line-length-limit
+	HandlerPathContractAccountOwners      = `/account/{address:(?i)` + base.REStringAddressString + `}/contract/owners` // revive:disable-line:line-length-limit
 	HandlerPathAccounts                   = `/accounts`
 	HandlerPathOperationBuildFactTemplate = `/builder/operation/fact/template/{fact:[\w][\w\-]*}`
 	HandlerPathOperationBuildFact         = `/builder/operation/fact`
 	HandlerPathOperationBuildSign         = `/builder/operation/sign`
 	HandlerPathOperationBuild             = `/builder/operation`
 	HandlerPathSend                       = `/builder/send`
+	HandlerPathSendStatus                 = `/builder/send/{facthash:(?i)[0-9a-z][0-9a-z]+}/status`
+	HandlerPathSimulate                   = `/builder/simulate`
+	HandlerPathStream                     = `/stream`
+	HandlerPathWebhooks                   = `/webhooks`
+	HandlerPathWebhook                    = `/webhooks/{id:[0-9a-f]+}`
+	HandlerPathFactFee                    = `/builder/fee`
 )
 
 var (
@@ -66,19 +80,28 @@ func init() {
 
 type Handlers struct {
 	*zerolog.Logger
-	networkID       base.NetworkID
-	encs            *encoder.Encoders
-	enc             encoder.Encoder
-	database        *Database
-	cache           Cache
-	nodeInfoHandler NodeInfoHandler
-	send            func(interface{}) (base.Operation, error)
-	client          func() (*isaacnetwork.BaseClient, *quicmemberlist.Memberlist, error)
-	router          *mux.Router
-	routes          map[ /* path */ string]*mux.Route
-	itemsLimiter    func(string /* request type */) int64
-	rg              *singleflight.Group
-	expireNotFilled time.Duration
+	networkID         base.NetworkID
+	encs              *encoder.Encoders
+	enc               encoder.Encoder
+	database          *Database
+	cache             Cache
+	nodeInfoHandler   NodeInfoHandler
+	send              func(interface{}) (base.Operation, error)
+	simulate          SimulateFunc
+	getState          base.GetStateFunc
+	builders          map[string]OperationBuilder
+	pendings          *PendingOperations
+	streamer          *Streamer
+	webhookAdminToken string
+	rateLimiter       *RateLimiter
+	authenticator     *Authenticator
+	corsOrigins       []string
+	client            func() (*isaacnetwork.BaseClient, *quicmemberlist.Memberlist, error)
+	router            *mux.Router
+	routes            map[ /* path */ string]*mux.Route
+	itemsLimiter      func(string /* request type */) int64
+	rg                *singleflight.Group
+	expireNotFilled   time.Duration
 }
 
 func NewHandlers(
@@ -104,6 +127,8 @@ func NewHandlers(
 		cache:           cache,
 		router:          router,
 		routes:          map[string]*mux.Route{},
+		builders:        map[string]OperationBuilder{},
+		pendings:        NewPendingOperations(),
 		itemsLimiter:    DefaultItemsLimiter,
 		rg:              &singleflight.Group{},
 		expireNotFilled: time.Second * 3,
@@ -111,13 +136,30 @@ func NewHandlers(
 }
 
 func (hd *Handlers) Initialize() error {
-	cors := handlers.CORS(
-		handlers.AllowedMethods([]string{"GET", "HEAD", "POST", "PUT", "OPTIONS"}),
-		handlers.AllowedHeaders([]string{"content-type"}),
-		handlers.AllowedOrigins([]string{"*"}),
-		handlers.AllowCredentials(),
-	)
-	hd.router.Use(cors)
+	origins := hd.corsOrigins
+	if len(origins) < 1 {
+		origins = []string{"*"}
+	}
+
+	corsOptions := []handlers.CORSOption{
+		handlers.AllowedMethods([]string{"GET", "HEAD", "POST", "PUT", "DELETE", "OPTIONS"}),
+		handlers.AllowedHeaders([]string{"content-type", "authorization", "x-api-key"}),
+		handlers.AllowedOrigins(origins),
+	}
+
+	// NOTE the credentials are allowed only for the explicit origins; with
+	// "*", any site could send the credentials of user.
+	if !slices.Contains(origins, "*") {
+		corsOptions = append(corsOptions, handlers.AllowCredentials())
+	}
+
+	hd.router.Use(handlers.CORS(corsOptions...))
+
+	if hd.authenticator != nil && len(hd.webhookAdminToken) > 0 {
+		_ = hd.authenticator.AddKey(
+			NewAPIKey("webhook-admin", hd.webhookAdminToken, []APIKeyScope{APIKeyScopeAdmin}),
+		)
+	}
 
 	hd.setHandlers()
 
@@ -145,6 +187,12 @@ func (hd *Handlers) Handler() http.Handler {
 func (hd *Handlers) setHandlers() {
 	_ = hd.setHandler(HandlerPathCurrencies, hd.handleCurrencies, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathCurrencyHolders, hd.handleCurrencyHolders, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathCurrencyBurns, hd.handleCurrencyBurns, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathCurrencyFee, hd.handleCurrencyFee, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathCurrency, hd.handleCurrency, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathManifests, hd.handleManifests, true).
@@ -167,18 +215,38 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathAccountOperations, hd.handleAccountOperations, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountVesting, hd.handleAccountVesting, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountAllowances, hd.handleAccountAllowances, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathContractAccount, hd.handleContractAccount, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathContractAccountOwners, hd.handleContractAccountOwners, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathAccounts, hd.handleAccounts, true).
 		Methods(http.MethodOptions, "GET")
-	// _ = hd.setHandler(HandlerPathOperationBuildFactTemplate, hd.handleOperationBuildFactTemplate, true).
-	// 	Methods(http.MethodOptions, "GET")
-	// _ = hd.setHandler(HandlerPathOperationBuildFact, hd.handleOperationBuildFact, false).
-	// 	Methods(http.MethodOptions, http.MethodPost)
-	// _ = hd.setHandler(HandlerPathOperationBuildSign, hd.handleOperationBuildSign, false).
-	// 	Methods(http.MethodOptions, http.MethodPost)
-	// _ = hd.setHandler(HandlerPathOperationBuild, hd.handleOperationBuild, true).
-	// 	Methods(http.MethodOptions, http.MethodGet, http.MethodPost)
+	_ = hd.setHandler(HandlerPathOperationBuildFactTemplate, hd.handleOperationBuildFactTemplate, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathOperationBuildFact, hd.handleOperationBuildFact, false).
+		Methods(http.MethodOptions, http.MethodPost)
+	_ = hd.setHandler(HandlerPathOperationBuildSign, hd.handleOperationBuildSign, false).
+		Methods(http.MethodOptions, http.MethodPost)
+	_ = hd.setHandler(HandlerPathOperationBuild, hd.handleOperationBuild, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathSend, hd.handleSend, false).
 		Methods(http.MethodOptions, http.MethodPost)
+	_ = hd.setHandler(HandlerPathSendStatus, hd.handleSendStatus, false).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathSimulate, hd.handleSimulate, false).
+		Methods(http.MethodOptions, http.MethodPost)
+	_ = hd.setHandler(HandlerPathFactFee, hd.handleFactFee, false).
+		Methods(http.MethodOptions, http.MethodPost)
+	_ = hd.setHandler(HandlerPathStream, hd.handleStream, false).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathWebhooks, hd.handleWebhooks, false).
+		Methods(http.MethodOptions, "GET", http.MethodPost)
+	_ = hd.setHandler(HandlerPathWebhook, hd.handleWebhook, false).
+		Methods(http.MethodOptions, "GET", http.MethodDelete)
 	_ = hd.setHandler(HandlerPathNodeInfo, hd.handleNodeInfo, true).
 		Methods(http.MethodOptions, "GET")
 }
@@ -207,16 +275,13 @@ func (hd *Handlers) setHandler(prefix string, h network.HTTPHandlerFunc, useCach
 		route = hd.router.Name(name)
 	}
 
-	/*
-		if rules, found := hd.rateLimit[prefix]; found {
-			handler = process.NewRateLimitMiddleware(
-				process.NewRateLimit(rules, limiter.Rate{Limit: -1}), // NOTE by default, unlimited
-				hd.rateLimitStore,
-			).Middleware(handler)
+	if hd.authenticator != nil {
+		handler = hd.authHandler(prefix, handler)
+	}
 
-			hd.Log().Debug().Str("prefix", prefix).Msg("ratelimit middleware attached")
-		}
-	*/
+	if hd.rateLimiter != nil {
+		handler = hd.rateLimitHandler(handler)
+	}
 
 	route = route.
 		Path(prefix).
@@ -7,6 +7,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"github.com/gorilla/mux"
@@ -97,6 +99,14 @@ func (hd *Handlers) buildAccountHal(va AccountValue) (Hal, error) {
 	}
 	hal = hal.AddLink("block", NewHalLink(h, nil))
 
+	if _, ok := va.ContractAccountStatus(); ok {
+		h, err = hd.combineURL(HandlerPathContractAccount, "address", hinted)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("contract", NewHalLink(h, nil))
+	}
+
 	return hal, nil
 }
 
@@ -116,14 +126,20 @@ func (hd *Handlers) handleAccountOperations(w http.ResponseWriter, r *http.Reque
 	limit := ParseLimitQuery(r.URL.Query().Get("limit"))
 	offset := ParseStringQuery(r.URL.Query().Get("offset"))
 	reverse := ParseBoolQuery(r.URL.Query().Get("reverse"))
+	memo := r.URL.Query().Get("memo")
+	if err := types.IsValidMemo(memo); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
 
-	cachekey := CacheKey(r.URL.Path, StringOffsetQuery(offset), StringBoolQuery("reverse", reverse))
+		return
+	}
+
+	cachekey := CacheKey(r.URL.Path, StringOffsetQuery(offset), StringBoolQuery("reverse", reverse), StringMemoQuery(memo))
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
 	}
 
 	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, filled, err := hd.handleAccountOperationsInGroup(address, offset, reverse, limit)
+		i, filled, err := hd.handleAccountOperationsInGroup(address, offset, memo, reverse, limit)
 
 		return []interface{}{i, filled}, err
 	}); err != nil {
@@ -153,6 +169,7 @@ func (hd *Handlers) handleAccountOperations(w http.ResponseWriter, r *http.Reque
 func (hd *Handlers) handleAccountOperationsInGroup(
 	address base.Address,
 	offset string,
+	memo string,
 	reverse bool,
 	l int64,
 ) ([]byte, bool, error) {
@@ -165,7 +182,7 @@ func (hd *Handlers) handleAccountOperationsInGroup(
 
 	var vas []Hal
 	if err := hd.database.OperationsByAddress(
-		address, true, reverse, offset, limit,
+		address, true, reverse, offset, memo, limit,
 		func(_ mitumutil.Hash, va OperationValue) (bool, error) {
 			hal, err := hd.buildOperationHal(va)
 			if err != nil {
@@ -181,7 +198,7 @@ func (hd *Handlers) handleAccountOperationsInGroup(
 		return nil, false, mitumutil.ErrNotFound.Errorf("operations in handleAccountsOperations")
 	}
 
-	i, err := hd.buildAccountOperationsHal(address, vas, offset, reverse)
+	i, err := hd.buildAccountOperationsHal(address, vas, offset, memo, reverse)
 	if err != nil {
 		return nil, false, err
 	}
@@ -194,6 +211,7 @@ func (hd *Handlers) buildAccountOperationsHal(
 	address base.Address,
 	vas []Hal,
 	offset string,
+	memo string,
 	reverse bool,
 ) (Hal, error) {
 	baseSelf, err := hd.combineURL(HandlerPathAccountOperations, "address", address.String())
@@ -201,6 +219,10 @@ func (hd *Handlers) buildAccountOperationsHal(
 		return nil, err
 	}
 
+	if len(memo) > 0 {
+		baseSelf = AddQueryValue(baseSelf, StringMemoQuery(memo))
+	}
+
 	self := baseSelf
 	if len(offset) > 0 {
 		self = AddQueryValue(baseSelf, StringOffsetQuery(offset))
@@ -243,6 +265,12 @@ func (hd *Handlers) buildAccountOperationsHal(
 }
 
 func (hd *Handlers) handleAccounts(w http.ResponseWriter, r *http.Request) {
+	if len(strings.TrimSpace(r.URL.Query().Get("owner"))) > 0 {
+		hd.handleAccountsByOwner(w, r)
+
+		return
+	}
+
 	offset := ParseStringQuery(r.URL.Query().Get("offset"))
 
 	var pub base.Publickey
@@ -323,6 +351,113 @@ func (hd *Handlers) handleAccounts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (hd *Handlers) handleAccountsByOwner(w http.ResponseWriter, r *http.Request) {
+	offset := ParseStringQuery(r.URL.Query().Get("offset"))
+
+	var owner base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(r.URL.Query().Get("owner")), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, fmt.Errorf("invalid owner query: %w", err), http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, fmt.Errorf("invalid owner query: %w", err), http.StatusBadRequest)
+
+		return
+	} else {
+		owner = a
+	}
+
+	offsetHeight := base.NilHeight
+	var offsetAddress string
+	if len(offset) > 0 {
+		h, a, err := parseOffsetByString(offset)
+		if err != nil {
+			HTTP2ProblemWithError(w, fmt.Errorf("invalid offset of accounts: %w", err), http.StatusBadRequest)
+
+			return
+		}
+
+		offsetHeight = h
+		offsetAddress = a
+	}
+
+	cachekey := CacheKey(r.URL.Path, owner.String(), offset)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if offsetHeight <= base.NilHeight {
+		offsetHeight = hd.database.LastBlock()
+	}
+
+	var lastaddress base.Address
+	i, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		var items []Hal
+		if err := hd.database.ContractAccountsByOwner(
+			owner, offsetHeight, offsetAddress, hd.itemsLimiter("accounts"),
+			func(st base.State) (bool, error) {
+				address := st.Key()[:len(st.Key())-len(extension.StateKeyContractAccountSuffix)]
+
+				hal, err := hd.buildContractAccountHal(address, st)
+				if err != nil {
+					return false, err
+				}
+				items = append(items, hal)
+
+				a, err := base.DecodeAddress(address, hd.enc)
+				if err != nil {
+					return false, err
+				}
+				lastaddress = a
+
+				return true, nil
+			},
+		); err != nil {
+			return nil, err
+		}
+
+		return items, nil
+	})
+	if err != nil {
+		hd.Log().Err(err).Stringer("owner", owner).Msg("failed to get accounts by owner")
+
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var items []Hal
+	if i != nil {
+		items = i.([]Hal)
+	}
+
+	switch hal, err := hd.buildAccountsHal(url.Values{
+		"owner": []string{owner.String()},
+	}, items, offset, offsetHeight, lastaddress); {
+	case err != nil:
+		HTTP2HandleError(w, err)
+
+		return
+	default:
+		b, err := hd.enc.Marshal(hal)
+		if err != nil {
+			HTTP2HandleError(w, err)
+
+			return
+		}
+		HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+	}
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offsetAddress) > 0 {
+			expire = time.Minute
+		}
+
+		HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
 func (*Handlers) buildAccountsHal(
 	queries url.Values,
 	vas []Hal,
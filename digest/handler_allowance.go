@@ -0,0 +1,131 @@
+package digest
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/gorilla/mux"
+)
+
+func (hd *Handlers) handleAccountAllowances(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var owner base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		owner = a
+	}
+
+	var spender base.Address
+	if s := strings.TrimSpace(r.URL.Query().Get("spender")); len(s) > 0 {
+		if a, err := base.DecodeAddress(s, hd.enc); err != nil {
+			HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		} else if err := a.IsValid(nil); err != nil {
+			HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+			return
+		} else {
+			spender = a
+		}
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountAllowancesInGroup(owner, spender)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountAllowancesInGroup(owner, spender base.Address) ([]byte, error) {
+	var vas []Hal
+	if err := hd.database.Allowances(
+		owner, spender,
+		func(st base.State) (bool, error) {
+			v, err := statecurrency.StateAllowanceValue(st)
+			if err != nil {
+				return false, err
+			}
+
+			// NOTE revoked allowance is kept as zero amount.
+			if !v.Amount.Big().OverZero() {
+				return true, nil
+			}
+
+			hal, err := hd.buildAllowanceHal(st)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("allowances of account, %v in handleAccountAllowances", owner)
+	}
+
+	h, err := hd.combineURL(HandlerPathAccountAllowances, "address", owner.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if spender != nil {
+		h += "?" + url.Values{"spender": []string{spender.String()}}.Encode()
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", owner.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) buildAllowanceHal(st base.State) (Hal, error) {
+	v, err := statecurrency.StateAllowanceValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(st, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", v.Spender.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("spender", NewHalLink(h, nil))
+
+	return hal, nil
+}
@@ -0,0 +1,67 @@
+package digest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// SetAuthenticator enables the API key authentication; without it, every
+// route is open.
+func (hd *Handlers) SetAuthenticator(au *Authenticator) *Handlers {
+	hd.authenticator = au
+
+	return hd
+}
+
+// SetCORSOrigins sets the allowed origins; empty origins allows any origin.
+func (hd *Handlers) SetCORSOrigins(origins []string) *Handlers {
+	hd.corsOrigins = origins
+
+	return hd
+}
+
+// authHandler checks the scope of route; the request without key or with
+// unknown key gets 401 problem and the key without scope gets 403 problem.
+func (hd *Handlers) authHandler(prefix string, h http.Handler) http.Handler {
+	scope, found := HandlerPathScopes[prefix]
+	if !found {
+		scope = APIKeyScopeRead
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		k, found := hd.authenticator.Authenticate(r)
+
+		switch {
+		case !found:
+			w.Header().Set("WWW-Authenticate", `Bearer realm="digest"`)
+			HTTP2ProblemWithError(w, errors.Errorf("unknown api key"), http.StatusUnauthorized)
+
+			return
+		case k.Allowed(scope):
+		case len(k.Name()) < 1:
+			w.Header().Set("WWW-Authenticate", `Bearer realm="digest"`)
+			HTTP2ProblemWithError(w, errors.Errorf("api key required for %q scope", scope), http.StatusUnauthorized)
+
+			return
+		default:
+			HTTP2ProblemWithError(w,
+				errors.Errorf("api key, %q not allowed for %q scope", k.Name(), scope), http.StatusForbidden)
+
+			return
+		}
+
+		if len(k.Name()) > 0 {
+			r = r.WithContext(context.WithValue(r.Context(), APIKeyContextKey, k.Name()))
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,218 @@
+package digest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+func (hd *Handlers) SetOperationBuilders(operations, facts []encoder.DecodeDetail) *Handlers {
+	hd.builders = NewOperationBuilders(operations, facts)
+
+	return hd
+}
+
+func (hd *Handlers) handleOperationBuild(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleOperationBuildInGroup()
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Hour)
+		}
+	}
+}
+
+func (hd *Handlers) handleOperationBuildInGroup() ([]byte, error) {
+	var hal Hal = NewBaseHal(nil, NewHalLink(HandlerPathOperationBuild, nil))
+
+	names := sortedOperationBuilderNames(hd.builders)
+	for i := range names {
+		h, err := hd.combineURL(HandlerPathOperationBuildFactTemplate, "fact", names[i])
+		if err != nil {
+			return nil, err
+		}
+
+		hal = hal.AddLink(fmt.Sprintf("operation-fact:%s", names[i]), NewHalLink(h, nil))
+	}
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) handleOperationBuildFactTemplate(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimSpace(mux.Vars(r)["fact"])
+
+	b, found := hd.operationBuilderByName(name)
+	if !found {
+		HTTP2ProblemWithError(w, errors.Errorf("unknown operation, %q", name), http.StatusNotFound)
+
+		return
+	}
+
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleOperationBuildFactTemplateInGroup(b)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Hour)
+		}
+	}
+}
+
+func (hd *Handlers) handleOperationBuildFactTemplateInGroup(b OperationBuilder) ([]byte, error) {
+	template, err := b.Template(hd.enc)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hd.combineURL(HandlerPathOperationBuildFactTemplate, "fact", b.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal = NewBaseHal(template, NewHalLink(h, nil))
+	hal = hal.AddLink("builder", NewHalLink(HandlerPathOperationBuildFact, nil))
+	hal = hal.AddExtras("fact_hint", b.FactHint().String())
+	hal = hal.AddExtras("operation_hint", b.OperationHint().String())
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) handleOperationBuildFact(w http.ResponseWriter, r *http.Request) {
+	body := &bytes.Buffer{}
+	if _, err := io.Copy(body, r.Body); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+
+	fact, m, err := BuildFact(hd.enc, hd.networkID, body.Bytes())
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	b, found := hd.operationBuilderByFact(fact)
+	if !found {
+		HTTP2ProblemWithError(w, errors.Errorf("fact, %T can not be built", fact), http.StatusBadRequest)
+
+		return
+	}
+
+	op, err := BuildOperation(hd.enc, b.OperationHint(), m, nil)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	var hal Hal = NewBaseHal(op, NewHalLink(HandlerPathOperationBuildFact, nil))
+	hal = hal.AddLink("sign", NewHalLink(HandlerPathOperationBuildSign, nil))
+	hal = hal.AddExtras("signature_base", util.ConcatBytesSlice(hd.networkID, fact.Hash().Bytes()))
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+}
+
+func (hd *Handlers) handleOperationBuildSign(w http.ResponseWriter, r *http.Request) {
+	body := &bytes.Buffer{}
+	if _, err := io.Copy(body, r.Body); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+
+	var u struct {
+		Fact  json.RawMessage   `json:"fact"`
+		Signs []json.RawMessage `json:"signs"`
+		Hint  hint.Hint         `json:"_hint"`
+	}
+
+	if err := util.UnmarshalJSON(body.Bytes(), &u); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	if len(u.Signs) < 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("empty signs"), http.StatusBadRequest)
+
+		return
+	}
+
+	op, err := BuildOperation(hd.enc, u.Hint, u.Fact, u.Signs)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	if err := op.IsValid(hd.networkID); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	var hal Hal = NewBaseHal(op, NewHalLink(HandlerPathOperationBuildSign, nil))
+	hal = hal.AddLink("send", NewHalLink(HandlerPathSend, nil))
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+}
+
+// operationBuilderByName finds the builder by name; the former names in
+// OperationBuilderAliases are also accepted.
+func (hd *Handlers) operationBuilderByName(name string) (OperationBuilder, bool) {
+	if b, found := hd.builders[name]; found {
+		return b, true
+	}
+
+	if alias, found := OperationBuilderAliases[name]; found {
+		b, found := hd.builders[alias]
+
+		return b, found
+	}
+
+	return OperationBuilder{}, false
+}
+
+func (hd *Handlers) operationBuilderByFact(fact base.Fact) (OperationBuilder, bool) {
+	hinter, ok := fact.(hint.Hinter)
+	if !ok {
+		return OperationBuilder{}, false
+	}
+
+	for i := range hd.builders {
+		if hd.builders[i].FactHint().Equal(hinter.Hint()) {
+			return hd.builders[i], true
+		}
+	}
+
+	return OperationBuilder{}, false
+}
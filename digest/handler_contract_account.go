@@ -0,0 +1,176 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/gorilla/mux"
+)
+
+func (hd *Handlers) handleContractAccountOwners(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleContractAccountOwnersInGroup(address)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleContractAccountOwnersInGroup(address base.Address) ([]byte, error) {
+	var vas []Hal
+	if err := hd.database.ContractAccountOwnerHistory(
+		address,
+		func(st base.State) (bool, error) {
+			hal, err := hd.buildContractAccountOwnerHal(st)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("contract account, %v in handleContractAccountOwners", address)
+	}
+
+	h, err := hd.combineURL(HandlerPathContractAccountOwners, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) buildContractAccountOwnerHal(st base.State) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(st, NewHalLink(h, nil))
+
+	ops := st.Operations()
+	for i := range ops {
+		h, err := hd.combineURL(HandlerPathOperation, "hash", ops[i].String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("operation:"+ops[i].String(), NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleContractAccount(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleContractAccountInGroup(address)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleContractAccountInGroup(address base.Address) ([]byte, error) {
+	switch st, found, err := hd.database.ContractAccountStatus(address); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, mitumutil.ErrNotFound.Errorf("contract account, %v in handleContractAccount", address)
+	default:
+		hal, err := hd.buildContractAccountHal(address.String(), st)
+		if err != nil {
+			return nil, err
+		}
+
+		return hd.enc.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildContractAccountHal(address string, st base.State) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathContractAccount, "address", address)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(st, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", address)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathContractAccountOwners, "address", address)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("owners", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
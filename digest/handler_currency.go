@@ -2,12 +2,14 @@ package digest
 
 import (
 	"fmt"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -128,3 +130,230 @@ func (hd *Handlers) buildCurrency(de types.CurrencyDesign, st base.State) (Hal,
 
 	return hal, nil
 }
+
+func (hd *Handlers) handleCurrencyHolders(w http.ResponseWriter, r *http.Request) {
+	cid := strings.TrimSpace(mux.Vars(r)["currencyid"])
+	if len(cid) < 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("empty currency id"), http.StatusBadRequest)
+
+		return
+	}
+
+	offset := ParseStringQuery(r.URL.Query().Get("offset"))
+
+	// NOTE the height of offset is the last block of previous page; the
+	// holders are paged by the latest balances.
+	var offsetAddress string
+	if len(offset) > 0 {
+		_, a, err := parseOffsetByString(offset)
+		if err != nil {
+			HTTP2ProblemWithError(w, fmt.Errorf("invalid offset of holders: %w", err), http.StatusBadRequest)
+
+			return
+		}
+
+		offsetAddress = a
+	}
+
+	cachekey := CacheKey(r.URL.Path, StringOffsetQuery(offset))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleCurrencyHoldersInGroup(cid, offset, offsetAddress)
+
+		return []interface{}{i, filled}, err
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		var b []byte
+		var filled bool
+		{
+			l := v.([]interface{})
+			b = l[0].([]byte)
+			filled = l[1].(bool)
+		}
+
+		HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+		if !shared {
+			expire := hd.expireNotFilled
+			if len(offset) > 0 && filled {
+				expire = time.Minute
+			}
+
+			HTTP2WriteCache(w, cachekey, expire)
+		}
+	}
+}
+
+func (hd *Handlers) handleCurrencyHoldersInGroup(
+	cid, offset string,
+	offsetAddress string,
+) ([]byte, bool, error) {
+	if _, _, err := hd.database.currency(cid); err != nil {
+		return nil, false, err
+	}
+
+	topHeight := hd.database.LastBlock()
+
+	limit := hd.itemsLimiter("currency-holders")
+
+	var vas []Hal
+	var lastaddress string
+	if err := hd.database.AccountsByBalance(cid, offsetAddress, limit,
+		func(st base.State) (bool, error) {
+			address := st.Key()[:len(st.Key())-len(statecurrency.StateKeyBalanceSuffix)-len(cid)-1]
+
+			hal, err := hd.buildCurrencyHolderHal(address, st)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			lastaddress = address
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathCurrencyHolders, "currencyid", cid)
+	if err != nil {
+		return nil, false, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = AddQueryValue(baseSelf, StringOffsetQuery(offset))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathCurrency, "currencyid", cid)
+	if err != nil {
+		return nil, false, err
+	}
+	hal = hal.AddLink("currency", NewHalLink(h, nil))
+
+	if len(lastaddress) > 0 {
+		next := AddQueryValue(baseSelf, StringOffsetQuery(buildOffsetByString(topHeight, lastaddress)))
+		hal = hal.AddLink("next", NewHalLink(next, nil))
+	}
+
+	b, err := hd.enc.Marshal(hal)
+
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildCurrencyHolderHal(address string, st base.State) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathAccount, "address", address)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(st, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleCurrencyBurns(w http.ResponseWriter, r *http.Request) {
+	cid := strings.TrimSpace(mux.Vars(r)["currencyid"])
+	if len(cid) < 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("empty currency id"), http.StatusBadRequest)
+
+		return
+	}
+
+	limit := ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := ParseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := CacheKey(r.URL.Path, StringOffsetQuery(offset), StringBoolQuery("reverse", reverse))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleCurrencyBurnsInGroup(cid, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		var b []byte
+		var filled bool
+		{
+			l := v.([]interface{})
+			b = l[0].([]byte)
+			filled = l[1].(bool)
+		}
+
+		HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+		if !shared {
+			expire := hd.expireNotFilled
+			if len(offset) > 0 && filled {
+				expire = time.Hour * 30
+			}
+
+			HTTP2WriteCache(w, cachekey, expire)
+		}
+	}
+}
+
+func (hd *Handlers) handleCurrencyBurnsInGroup(
+	cid, offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	if _, _, err := hd.database.currency(cid); err != nil {
+		return nil, false, err
+	}
+
+	filter, err := buildOperationsFilterByOffset(offset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+	// NOTE the failed burn operations did not burn.
+	filter["burned.currency"] = cid
+	filter["d.in_state"] = true
+
+	var vas []Hal
+	switch l, _, e := hd.loadOperationsHALFromDatabase(filter, reverse, l); {
+	case e != nil:
+		return nil, false, e
+	case len(l) < 1:
+		return nil, false, mitumutil.ErrNotFound.Errorf("burn operations in handleCurrencyBurns")
+	default:
+		vas = l
+	}
+
+	h, err := hd.combineURL(HandlerPathCurrencyBurns, "currencyid", cid)
+	if err != nil {
+		return nil, false, err
+	}
+	hal := hd.buildOperationsHal(h, vas, offset, reverse)
+	if next := nextOffsetOfOperations(h, vas, reverse); len(next) > 0 {
+		hal = hal.AddLink("next", NewHalLink(next, nil))
+	}
+
+	ch, err := hd.combineURL(HandlerPathCurrency, "currencyid", cid)
+	if err != nil {
+		return nil, false, err
+	}
+	hal = hal.AddLink("currency", NewHalLink(ch, nil))
+
+	b, err := hd.enc.Marshal(hal)
+
+	return b, int64(len(vas)) == hd.itemsLimiter("operations"), err
+}
@@ -0,0 +1,126 @@
+package digest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+func (hd *Handlers) SetGetStateFunc(f base.GetStateFunc) *Handlers {
+	hd.getState = f
+
+	return hd
+}
+
+func (hd *Handlers) handleCurrencyFee(w http.ResponseWriter, r *http.Request) {
+	if hd.getState == nil {
+		HTTP2NotSupported(w, errors.Errorf("fee estimation not supported"))
+
+		return
+	}
+
+	cid := types.CurrencyID(strings.TrimSpace(mux.Vars(r)["currencyid"]))
+	if err := cid.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, errors.Wrap(err, "invalid currency id"), http.StatusBadRequest)
+
+		return
+	}
+
+	s := strings.TrimSpace(r.URL.Query().Get("amount"))
+	if len(s) < 1 {
+		s = "0"
+	}
+
+	big, err := common.NewBigFromString(s)
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Wrap(err, "invalid amount"), http.StatusBadRequest)
+
+		return
+	}
+
+	cachekey := CacheKey(r.URL.Path, big.String())
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleCurrencyFeeInGroup(types.NewAmount(big, cid))
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleCurrencyFeeInGroup(amount types.Amount) ([]byte, error) {
+	fe, err := CalculateAmountFee(hd.getState, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hd.combineURL(HandlerPathCurrency, "currencyid", amount.Currency().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal = NewBaseHal(fe, HalLink{})
+	hal = hal.AddLink("currency", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) handleFactFee(w http.ResponseWriter, r *http.Request) {
+	if hd.getState == nil {
+		HTTP2NotSupported(w, errors.Errorf("fee estimation not supported"))
+
+		return
+	}
+
+	body := &bytes.Buffer{}
+	if _, err := io.Copy(body, r.Body); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+
+	hinter, err := hd.enc.Decode(body.Bytes())
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	fact, ok := hinter.(base.Fact)
+	if !ok {
+		HTTP2ProblemWithError(w, errors.Errorf("expected Fact, not %T", hinter), http.StatusBadRequest)
+
+		return
+	}
+
+	if err := fact.IsValid(hd.networkID); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	fes, err := CalculateFactFee(hd.getState, fact)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	HTTP2WriteHal(hd.enc, w, NewBaseHal(fes, HalLink{}), http.StatusOK)
+}
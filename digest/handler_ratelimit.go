@@ -0,0 +1,58 @@
+package digest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func (hd *Handlers) SetRateLimiter(rl *RateLimiter) *Handlers {
+	hd.rateLimiter = rl
+
+	return hd
+}
+
+// rateLimitHandler returns 429 problem when the client exceeds the limit; when
+// the store fails, the request is not limited. It wraps authHandler, so the
+// requests rejected by authentication are also limited; the known API key is
+// resolved here to count the request by the key name. With authenticator, the
+// name is always set, even if empty, so RateLimiter does not trust the raw key
+// in header.
+func (hd *Handlers) rateLimitHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hd.authenticator != nil {
+			var name string
+			if k, found := hd.authenticator.Authenticate(r); found {
+				name = k.Name()
+			}
+
+			r = r.WithContext(context.WithValue(r.Context(), APIKeyContextKey, name))
+		}
+
+		result, limited, err := hd.rateLimiter.Take(r)
+
+		switch {
+		case err != nil:
+			hd.Log().Err(err).Str("path", r.URL.Path).Msg("failed to check rate limit")
+		case !limited:
+		default:
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(result.Reset.Unix(), 10))
+
+			if !result.Allowed {
+				retry := int64(time.Until(result.Reset).Seconds()) + 1
+				w.Header().Set("Retry-After", strconv.FormatInt(retry, 10))
+
+				HTTP2ProblemWithError(w, errors.Errorf("too many requests"), http.StatusTooManyRequests)
+
+				return
+			}
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
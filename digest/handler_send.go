@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
 
@@ -20,6 +22,11 @@ import (
 //	return hd
 //}
 
+// PendingOperations returns the operations sent through this node.
+func (hd *Handlers) PendingOperations() *PendingOperations {
+	return hd.pendings
+}
+
 func (hd *Handlers) handleSend(w http.ResponseWriter, r *http.Request) {
 	body := &bytes.Buffer{}
 	if _, err := io.Copy(body, r.Body); err != nil {
@@ -78,6 +85,9 @@ func (hd *Handlers) sendOperation(v interface{}) (Hal, error) {
 			nodeList = append(nodeList, node.ConnInfo())
 			return true
 		})
+
+		_ = hd.pendings.Queue(op, hd.database.LastBlock())
+
 		for i := range nodeList {
 			//buf := bytes.NewBuffer(nil)
 			//if err := json.NewEncoder(buf).Encode(op); err != nil {
@@ -88,9 +98,13 @@ func (hd *Handlers) sendOperation(v interface{}) (Hal, error) {
 
 			_, err := client.SendOperation(ctx, nodeList[i], op)
 			if err != nil {
+				hd.pendings.Remove(op.Fact().Hash())
+
 				return nil, err
 			}
 		}
+
+		hd.pendings.Broadcast(op.Fact().Hash())
 	}
 
 	return hd.buildSealHal(op)
@@ -98,6 +112,12 @@ func (hd *Handlers) sendOperation(v interface{}) (Hal, error) {
 
 func (hd *Handlers) buildSealHal(op base.Operation) (Hal, error) {
 	var hal Hal = NewBaseHal(op, HalLink{})
+
+	h, err := hd.combineURL(HandlerPathSendStatus, "facthash", op.Fact().Hash().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("status", NewHalLink(h, nil))
 	/*
 		if t, ok := sl.(operation.Seal); ok {
 			for i := range t.Operations() {
@@ -113,3 +133,62 @@ func (hd *Handlers) buildSealHal(op base.Operation) (Hal, error) {
 
 	return hal, nil
 }
+
+func (hd *Handlers) handleSendStatus(w http.ResponseWriter, r *http.Request) {
+	h, err := parseHashFromPath(mux.Vars(r)["facthash"])
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Wrap(err, "invalid fact hash"), http.StatusBadRequest)
+
+		return
+	}
+
+	if hal, err := hd.buildSendStatusHal(h); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+	}
+}
+
+func (hd *Handlers) buildSendStatusHal(h mitumutil.Hash) (Hal, error) {
+	var va *OperationValue
+
+	switch i, found, err := hd.database.Operation(h, true); {
+	case err != nil:
+		return nil, err
+	case found:
+		va = &i
+	}
+
+	p, found := hd.pendings.Update(h, va, hd.database.LastBlock())
+
+	switch {
+	case found:
+	case va == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("operation %v in handleSendStatus", h)
+	default:
+		p = NewPendingOperationInBlock(*va)
+	}
+
+	self, err := hd.combineURL(HandlerPathSendStatus, "facthash", h.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal = NewBaseHal(p, NewHalLink(self, nil))
+
+	if p.Status == PendingOperationInBlock {
+		oh, err := hd.combineURL(HandlerPathOperation, "hash", h.String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("operation", NewHalLink(oh, nil))
+
+		bh, err := hd.combineURL(HandlerPathBlockByHeight, "height", p.Height.String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("block", NewHalLink(bh, nil))
+	}
+
+	return hal, nil
+}
@@ -0,0 +1,65 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+func (hd *Handlers) SetSimulateFunc(f SimulateFunc) *Handlers {
+	hd.simulate = f
+
+	return hd
+}
+
+func (hd *Handlers) handleSimulate(w http.ResponseWriter, r *http.Request) {
+	if hd.simulate == nil {
+		HTTP2NotSupported(w, errors.Errorf("simulation not supported"))
+
+		return
+	}
+
+	body := &bytes.Buffer{}
+	if _, err := io.Copy(body, r.Body); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+
+	hinter, err := hd.enc.Decode(body.Bytes())
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	op, ok := hinter.(base.Operation)
+	if !ok {
+		HTTP2ProblemWithError(w, errors.Errorf("expected Operation, not %T", hinter), http.StatusBadRequest)
+
+		return
+	}
+
+	if err := op.IsValid(hd.networkID); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), time.Second*9)
+	defer cancel()
+
+	sr, err := hd.simulate(ctx, op)
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	HTTP2WriteHal(hd.enc, w, NewBaseHal(sr, HalLink{}), http.StatusOK)
+}
@@ -0,0 +1,210 @@
+package digest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var StreamHeartbeatInterval = time.Second * 15
+
+func (hd *Handlers) SetStreamer(s *Streamer) *Handlers {
+	hd.streamer = s
+
+	return hd
+}
+
+// handleStream pushes the events of the digested blocks as server-sent events.
+// The id of event is "<height>-<index>"; by Last-Event-ID header the
+// reconnected client receives the missed events.
+func (hd *Handlers) handleStream(w http.ResponseWriter, r *http.Request) {
+	if hd.streamer == nil {
+		HTTP2NotSupported(w, errors.Errorf("stream not supported"))
+
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		HTTP2NotSupported(w, errors.Errorf("stream not supported by connection"))
+
+		return
+	}
+
+	filter, err := parseStreamFilter(r.URL.Query(), hd.enc)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	lastID := r.Header.Get("Last-Event-ID")
+	if len(lastID) < 1 {
+		lastID = r.URL.Query().Get("last_event_id")
+	}
+
+	height, index, err := parseStreamEventID(lastID)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	ch, cancel := hd.streamer.Subscribe(filter, height, index)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(StreamHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+
+			flusher.Flush()
+		case e, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			b, err := hd.enc.Marshal(e)
+			if err != nil {
+				hd.Log().Err(err).Msg("failed to marshal stream event")
+
+				return
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %d-%d\nevent: %s\ndata: %s\n\n", e.Height, e.Index, e.Type, b); err != nil {
+				return
+			}
+
+			flusher.Flush()
+		}
+	}
+}
+
+func parseStreamFilter(q url.Values, enc encoder.Encoder) (StreamFilter, error) {
+	values := func(key string) []string {
+		var l []string
+
+		for _, v := range q[key] {
+			for _, s := range strings.Split(v, ",") {
+				if s = strings.TrimSpace(s); len(s) > 0 {
+					l = append(l, s)
+				}
+			}
+		}
+
+		return l
+	}
+
+	return NewStreamFilter(values("type"), values("address"), values("currency"), values("hint"), enc)
+}
+
+// NewStreamFilter makes StreamFilter from the strings. hints can be the hint
+// or hint type of operation.
+func NewStreamFilter(eventTypes, addresses, currencies, hints []string, enc encoder.Encoder) (StreamFilter, error) {
+	var filter StreamFilter
+
+	if len(eventTypes) > 0 {
+		filter.Types = map[StreamEventType]struct{}{}
+
+		for i := range eventTypes {
+			switch t := StreamEventType(eventTypes[i]); t {
+			case StreamEventManifest, StreamEventOperation, StreamEventBalance:
+				filter.Types[t] = struct{}{}
+			default:
+				return filter, errors.Errorf("unknown event type, %q", eventTypes[i])
+			}
+		}
+	}
+
+	if len(addresses) > 0 {
+		filter.Addresses = map[string]struct{}{}
+
+		for i := range addresses {
+			a, err := base.DecodeAddress(addresses[i], enc)
+			if err != nil {
+				return filter, errors.WithMessagef(err, "invalid address, %q", addresses[i])
+			} else if err := a.IsValid(nil); err != nil {
+				return filter, errors.WithMessagef(err, "invalid address, %q", addresses[i])
+			}
+
+			filter.Addresses[a.String()] = struct{}{}
+		}
+	}
+
+	if len(currencies) > 0 {
+		filter.Currencies = map[types.CurrencyID]struct{}{}
+
+		for i := range currencies {
+			cid := types.CurrencyID(currencies[i])
+			if err := cid.IsValid(nil); err != nil {
+				return filter, err
+			}
+
+			filter.Currencies[cid] = struct{}{}
+		}
+	}
+
+	if len(hints) > 0 {
+		filter.Hints = map[string]struct{}{}
+
+		for i := range hints {
+			if ht, err := hint.ParseHint(hints[i]); err == nil {
+				filter.Hints[ht.Type().String()] = struct{}{}
+
+				continue
+			}
+
+			filter.Hints[hints[i]] = struct{}{}
+		}
+	}
+
+	return filter, nil
+}
+
+func parseStreamEventID(s string) (base.Height, uint64, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 1 {
+		return base.NilHeight, 0, nil
+	}
+
+	i := strings.Index(s, "-")
+	if i < 1 {
+		return base.NilHeight, 0, errors.Errorf("invalid event id, %q", s)
+	}
+
+	height, err := base.ParseHeightString(s[:i])
+	if err != nil {
+		return base.NilHeight, 0, errors.WithMessagef(err, "invalid event id, %q", s)
+	}
+
+	index, err := strconv.ParseUint(s[i+1:], 10, 64)
+	if err != nil {
+		return base.NilHeight, 0, errors.WithMessagef(err, "invalid event id, %q", s)
+	}
+
+	return height, index, nil
+}
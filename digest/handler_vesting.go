@@ -0,0 +1,104 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/gorilla/mux"
+)
+
+func (hd *Handlers) handleAccountVesting(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountVestingInGroup(address)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountVestingInGroup(address base.Address) ([]byte, error) {
+	// NOTE the claimable amount is calculated at the last block; the next
+	// ClaimVesting will be processed in the later block, so it could claim
+	// more.
+	top := hd.database.LastBlock()
+
+	var vas []Hal
+	if err := hd.database.Vestings(
+		address,
+		func(st base.State) (bool, error) {
+			hal, err := hd.buildVestingHal(st, top)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("vesting of account, %v in handleAccountVesting", address)
+	}
+
+	h, err := hd.combineURL(HandlerPathAccountVesting, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) buildVestingHal(st base.State, top base.Height) (Hal, error) {
+	v, err := statecurrency.StateVestingValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(st, NewHalLink(h, nil))
+	hal = hal.
+		AddExtras("locked", v.Locked().String()).
+		AddExtras("claimable", v.Claimable(top).String())
+
+	return hal, nil
+}
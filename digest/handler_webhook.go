@@ -0,0 +1,200 @@
+package digest
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+// SetWebhookAdminToken enables the webhook admin endpoints; the request should
+// have the token as bearer token of Authorization header. With Authenticator,
+// the token is added as the key of APIKeyScopeAdmin.
+func (hd *Handlers) SetWebhookAdminToken(token string) *Handlers {
+	hd.webhookAdminToken = token
+
+	return hd
+}
+
+func (hd *Handlers) checkWebhookAdmin(w http.ResponseWriter, r *http.Request) bool {
+	if len(hd.webhookAdminToken) < 1 {
+		HTTP2NotSupported(w, errors.Errorf("webhook not supported"))
+
+		return false
+	}
+
+	if hd.authenticator != nil { // NOTE scope is checked by authHandler
+		return true
+	}
+
+	token := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(hd.webhookAdminToken)) != 1 {
+		HTTP2ProblemWithError(w, errors.Errorf("unauthorized"), http.StatusUnauthorized)
+
+		return false
+	}
+
+	return true
+}
+
+func (hd *Handlers) handleWebhooks(w http.ResponseWriter, r *http.Request) {
+	if !hd.checkWebhookAdmin(w, r) {
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		hd.handleNewWebhook(w, r)
+
+		return
+	}
+
+	whs, err := hd.database.Webhooks()
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	hals := make([]Hal, len(whs))
+
+	for i := range whs {
+		hal, err := hd.buildWebhookHal(whs[i], false)
+		if err != nil {
+			HTTP2HandleError(w, err)
+
+			return
+		}
+
+		hals[i] = hal
+	}
+
+	HTTP2WriteHal(hd.enc, w, NewBaseHal(hals, NewHalLink(HandlerPathWebhooks, nil)), http.StatusOK)
+}
+
+func (hd *Handlers) handleNewWebhook(w http.ResponseWriter, r *http.Request) {
+	body := &bytes.Buffer{}
+	if _, err := io.Copy(body, r.Body); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+
+	var wh Webhook
+	if err := mitumutil.UnmarshalJSON(body.Bytes(), &wh); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	id, err := NewWebhookID()
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	wh.ID = id
+	wh.CreatedAt = localtime.Now().UTC()
+	wh.Status = WebhookStatus{DeliveredHeight: base.NilHeight}
+
+	if len(wh.Secret) < 1 {
+		secret, err := NewWebhookID()
+		if err != nil {
+			HTTP2HandleError(w, err)
+
+			return
+		}
+
+		wh.Secret = secret
+	}
+
+	if err := wh.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	if _, err := wh.Filter(hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	if err := hd.database.SetWebhook(wh); err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	hal, err := hd.buildWebhookHal(wh, true)
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusCreated)
+}
+
+func (hd *Handlers) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if !hd.checkWebhookAdmin(w, r) {
+		return
+	}
+
+	id := strings.TrimSpace(mux.Vars(r)["id"])
+
+	if r.Method == http.MethodDelete {
+		hd.handleRemoveWebhook(w, id)
+
+		return
+	}
+
+	switch wh, found, err := hd.database.Webhook(id); {
+	case err != nil:
+		HTTP2HandleError(w, err)
+	case !found:
+		HTTP2ProblemWithError(w, errors.Errorf("webhook, %q not found", id), http.StatusNotFound)
+	default:
+		hal, err := hd.buildWebhookHal(wh, false)
+		if err != nil {
+			HTTP2HandleError(w, err)
+
+			return
+		}
+
+		HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+	}
+}
+
+func (hd *Handlers) handleRemoveWebhook(w http.ResponseWriter, id string) {
+	switch removed, err := hd.database.RemoveWebhook(id); {
+	case err != nil:
+		HTTP2HandleError(w, err)
+	case !removed:
+		HTTP2ProblemWithError(w, errors.Errorf("webhook, %q not found", id), http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// buildWebhookHal hides the secret except when the webhook is created.
+func (hd *Handlers) buildWebhookHal(wh Webhook, withSecret bool) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathWebhook, "id", wh.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !withSecret {
+		wh.Secret = ""
+	}
+
+	return NewBaseHal(wh, NewHalLink(h, nil)), nil
+}
@@ -48,7 +48,52 @@ var balanceIndexModels = []mongo.IndexModel{
 	},
 }
 
+var latestBalanceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "currency", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_latest_balance").
+			SetUnique(true),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "currency", Value: 1},
+			bson.E{Key: "amount_key", Value: -1},
+			bson.E{Key: "address", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_latest_balance_holders"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_latest_balance_height"),
+	},
+}
+
 var operationIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "memos.address", Value: 1},
+			bson.E{Key: "memos.memo", Value: 1},
+			bson.E{Key: "height", Value: 1},
+			bson.E{Key: "index", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_account_operation_memo").
+			SetSparse(true),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "burned.currency", Value: 1},
+			bson.E{Key: "d.in_state", Value: 1},
+			bson.E{Key: "height", Value: 1},
+			bson.E{Key: "index", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_currency_burn_operation").
+			SetSparse(true),
+	},
 	{
 		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
 		Options: options.Index().
@@ -66,8 +111,66 @@ var operationIndexModels = []mongo.IndexModel{
 	},
 }
 
+var vestingIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "address", Value: 1},
+			bson.E{Key: "currency", Value: 1},
+			bson.E{Key: "sender", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_vesting"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_vesting_height"),
+	},
+}
+
+var allowanceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "owner", Value: 1},
+			bson.E{Key: "spender", Value: 1},
+			bson.E{Key: "currency", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_allowance"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_height"),
+	},
+}
+
+var contractAccountIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_contract_account"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_contract_account_height"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "owner", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_contract_account_owner"),
+	},
+}
+
 var defaultIndexes = map[string] /* collection */ []mongo.IndexModel{
-	defaultColNameAccount:   accountIndexModels,
-	defaultColNameBalance:   balanceIndexModels,
-	defaultColNameOperation: operationIndexModels,
+	defaultColNameAccount:         accountIndexModels,
+	defaultColNameContractAccount: contractAccountIndexModels,
+	defaultColNameBalance:         balanceIndexModels,
+	defaultColNameLatestBalance:   latestBalanceIndexModels,
+	defaultColNameVesting:         vestingIndexModels,
+	defaultColNameAllowance:       allowanceIndexModels,
+	defaultColNameOperation:       operationIndexModels,
 }
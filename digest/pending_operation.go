@@ -0,0 +1,161 @@
+package digest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+)
+
+// PendingOperationExpire is the number of blocks; the operation, which is not
+// digested until then since it was sent, is regarded as dropped.
+var PendingOperationExpire base.Height = 30
+
+type PendingOperationStatus string
+
+const (
+	PendingOperationQueued    = PendingOperationStatus("queued")
+	PendingOperationBroadcast = PendingOperationStatus("broadcast")
+	PendingOperationInBlock   = PendingOperationStatus("in-block")
+	PendingOperationExpired   = PendingOperationStatus("expired")
+)
+
+// PendingOperation is the status of operation sent through /builder/send.
+// SentHeight is the last digested height when it was sent. Height, InState and
+// Reason are set when the operation is found in the digested block.
+type PendingOperation struct {
+	Fact       mitumutil.Hash         `json:"fact"`
+	Operation  mitumutil.Hash         `json:"operation"`
+	Status     PendingOperationStatus `json:"status"`
+	SentAt     time.Time              `json:"sent_at"`
+	SentHeight base.Height            `json:"sent_height"`
+	Height     base.Height            `json:"height,omitempty"`
+	InState    bool                   `json:"in_state"`
+	Reason     string                 `json:"reason,omitempty"`
+}
+
+// PendingOperations keeps the operations sent by this node by fact hash in
+// memory; it is per node and not shared with the other API nodes, so the
+// status of the operation sent through the other node is known only after it
+// is digested. The ones sent over 2*PendingOperationExpire blocks ago are
+// pruned whenever a block is digested.
+type PendingOperations struct {
+	ops map[string]PendingOperation
+	sync.RWMutex
+}
+
+func NewPendingOperations() *PendingOperations {
+	return &PendingOperations{
+		ops: map[string]PendingOperation{},
+	}
+}
+
+func (po *PendingOperations) Queue(op base.Operation, height base.Height) PendingOperation {
+	po.Lock()
+	defer po.Unlock()
+
+	po.prune(height)
+
+	p := PendingOperation{
+		Fact:       op.Fact().Hash(),
+		Operation:  op.Hash(),
+		Status:     PendingOperationQueued,
+		SentAt:     localtime.Now().UTC(),
+		SentHeight: height,
+	}
+
+	po.ops[p.Fact.String()] = p
+
+	return p
+}
+
+func (po *PendingOperations) Broadcast(fact mitumutil.Hash) {
+	po.Lock()
+	defer po.Unlock()
+
+	if p, found := po.ops[fact.String()]; found && p.Status == PendingOperationQueued {
+		p.Status = PendingOperationBroadcast
+
+		po.ops[fact.String()] = p
+	}
+}
+
+func (po *PendingOperations) Remove(fact mitumutil.Hash) {
+	po.Lock()
+	defer po.Unlock()
+
+	delete(po.ops, fact.String())
+}
+
+func (po *PendingOperations) Get(fact mitumutil.Hash) (PendingOperation, bool) {
+	po.RLock()
+	defer po.RUnlock()
+
+	p, found := po.ops[fact.String()]
+
+	return p, found
+}
+
+// Update updates the status by the digested operation; va is nil when the
+// operation is not yet digested, height is the last digested height.
+func (po *PendingOperations) Update(fact mitumutil.Hash, va *OperationValue, height base.Height) (PendingOperation, bool) {
+	po.Lock()
+	defer po.Unlock()
+
+	p, found := po.ops[fact.String()]
+
+	switch {
+	case !found:
+		return p, false
+	case va != nil:
+		p = p.inBlock(*va)
+	case p.Status == PendingOperationInBlock, p.Status == PendingOperationExpired:
+		return p, true
+	case height > p.SentHeight+PendingOperationExpire:
+		p.Status = PendingOperationExpired
+	}
+
+	po.ops[fact.String()] = p
+
+	return p, true
+}
+
+// NewPendingOperationInBlock returns the status of digested operation, which
+// was not sent through this node.
+func NewPendingOperationInBlock(va OperationValue) PendingOperation {
+	return PendingOperation{
+		Fact:      va.Operation().Fact().Hash(),
+		Operation: va.Operation().Hash(),
+	}.inBlock(va)
+}
+
+func (p PendingOperation) inBlock(va OperationValue) PendingOperation {
+	p.Status = PendingOperationInBlock
+	p.Height = va.Height()
+	p.InState = va.InState()
+
+	if va.Reason() != nil {
+		p.Reason = va.Reason().Error()
+	}
+
+	return p
+}
+
+// Prune removes the operations sent over 2*PendingOperationExpire blocks before
+// height; it is called by Digester with the digested height.
+func (po *PendingOperations) Prune(height base.Height) {
+	po.Lock()
+	defer po.Unlock()
+
+	po.prune(height)
+}
+
+func (po *PendingOperations) prune(height base.Height) {
+	for k := range po.ops {
+		if height > po.ops[k].SentHeight+PendingOperationExpire*2 {
+			delete(po.ops, k)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package digest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ProtoconNet/mitum-currency/v3/digest/cache"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	DefaultRateLimitStore     = "memory://"
+	DefaultRateLimitNamespace = "ratelimit"
+	RateLimitPruneInterval    = time.Minute
+)
+
+// RateLimitRule allows Limit requests in Period. The negative Limit is
+// unlimited.
+type RateLimitRule struct {
+	Limit  int
+	Period time.Duration
+}
+
+// ParseRateLimitRule parses "<limit>/<period>", like "100/1m", or "unlimited".
+func ParseRateLimitRule(s string) (RateLimitRule, error) {
+	s = strings.TrimSpace(s)
+	if s == "unlimited" {
+		return RateLimitRule{Limit: -1}, nil
+	}
+
+	i := strings.Index(s, "/")
+	if i < 1 {
+		return RateLimitRule{}, errors.Errorf("invalid rate limit rule, %q", s)
+	}
+
+	limit, err := strconv.ParseUint(s[:i], 10, 32)
+	if err != nil {
+		return RateLimitRule{}, errors.Wrapf(err, "invalid limit of rate limit rule, %q", s)
+	}
+
+	period, err := time.ParseDuration(s[i+1:])
+	if err != nil {
+		return RateLimitRule{}, errors.Wrapf(err, "invalid period of rate limit rule, %q", s)
+	}
+
+	rule := RateLimitRule{Limit: int(limit), Period: period}
+
+	return rule, rule.IsValid(nil)
+}
+
+func (r RateLimitRule) IsValid([]byte) error {
+	if r.Limit >= 0 && r.Period < time.Second {
+		return errors.Errorf("too short period of rate limit rule, %v", r.Period)
+	}
+
+	return nil
+}
+
+func (r RateLimitRule) Unlimited() bool {
+	return r.Limit < 0
+}
+
+type RateLimitResult struct {
+	Reset     time.Time
+	Limit     int
+	Remaining int
+	Allowed   bool
+}
+
+// RateLimitStore counts the requests of key in the current period of rule.
+type RateLimitStore interface {
+	Take(key string, rule RateLimitRule) (RateLimitResult, error)
+}
+
+func NewRateLimitStoreFromURI(uri string) (RateLimitStore, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid uri of rate limit store, %v", uri)
+	}
+
+	switch {
+	case u.Scheme == "memory":
+		return NewMemoryRateLimitStore(), nil
+	case u.Scheme == "redis", u.Scheme == "rediss":
+		return NewRedisRateLimitStore(u)
+	default:
+		return nil, errors.Errorf("unsupported uri of rate limit store, %v", uri)
+	}
+}
+
+type rateLimitWindow struct {
+	reset time.Time
+	count int
+}
+
+// MemoryRateLimitStore counts the requests in memory; it is not shared with
+// the other API nodes.
+type MemoryRateLimitStore struct {
+	windows map[string]rateLimitWindow
+	pruned  time.Time
+	sync.Mutex
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		windows: map[string]rateLimitWindow{},
+		pruned:  time.Now(),
+	}
+}
+
+func (st *MemoryRateLimitStore) Take(key string, rule RateLimitRule) (RateLimitResult, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	now := time.Now()
+
+	if now.Sub(st.pruned) > RateLimitPruneInterval {
+		for k := range st.windows {
+			if !now.Before(st.windows[k].reset) {
+				delete(st.windows, k)
+			}
+		}
+
+		st.pruned = now
+	}
+
+	w, found := st.windows[key]
+	if !found || !now.Before(w.reset) {
+		w = rateLimitWindow{reset: now.Add(rule.Period)}
+	}
+
+	w.count++
+	st.windows[key] = w
+
+	return newRateLimitResult(rule, w.count, w.reset), nil
+}
+
+// RedisRateLimitStore counts the requests in redis, so the API nodes share
+// the limits.
+type RedisRateLimitStore struct {
+	cl        *redis.Client
+	namespace string
+}
+
+func NewRedisRateLimitStore(u *url.URL) (*RedisRateLimitStore, error) {
+	cl, err := cache.NewRedisClientFromURL(u)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := DefaultRateLimitNamespace
+	if s := u.Query().Get("namespace"); len(s) > 0 {
+		namespace = s
+	}
+
+	return &RedisRateLimitStore{cl: cl, namespace: namespace}, nil
+}
+
+func (st *RedisRateLimitStore) Take(key string, rule RateLimitRule) (RateLimitResult, error) {
+	ctx := context.Background()
+	k := st.namespace + ":" + key
+
+	var count *redis.IntCmd
+	var pttl *redis.DurationCmd
+
+	if _, err := st.cl.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.Incr(ctx, k)
+		pttl = pipe.PTTL(ctx, k)
+
+		return nil
+	}); err != nil {
+		return RateLimitResult{}, err
+	}
+
+	ttl := pttl.Val()
+
+	if ttl < 0 {
+		ttl = rule.Period
+
+		if err := st.cl.PExpire(ctx, k, ttl).Err(); err != nil {
+			return RateLimitResult{}, err
+		}
+	}
+
+	return newRateLimitResult(rule, int(count.Val()), time.Now().Add(ttl)), nil
+}
+
+func newRateLimitResult(rule RateLimitRule, count int, reset time.Time) RateLimitResult {
+	remaining := rule.Limit - count
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return RateLimitResult{
+		Reset:     reset,
+		Limit:     rule.Limit,
+		Remaining: remaining,
+		Allowed:   count <= rule.Limit,
+	}
+}
+
+type rateLimitPrefix struct {
+	prefix string
+	rule   RateLimitRule
+}
+
+// RateLimiter limits the requests by route prefix and client. The client is
+// the name of the authenticated API key, the API key in APIKeyHeader when the
+// key has its own rules and no authenticator is set, or the IP address. The requests under the same
+// prefix share the limit.
+type RateLimiter struct {
+	store          RateLimitStore
+	rules          []rateLimitPrefix
+	clients        map[string][]rateLimitPrefix
+	ipHeader       string
+	trustedProxies int
+}
+
+// NewRateLimiter makes RateLimiter; the keys of rules are the route prefixes
+// and clients has the rules of the particular IP addresses, API keys or the
+// names of API keys.
+func NewRateLimiter(
+	rules map[string]RateLimitRule,
+	clients map[string]map[string]RateLimitRule,
+	store RateLimitStore,
+) *RateLimiter {
+	rl := &RateLimiter{
+		store:   store,
+		rules:   sortRateLimitPrefixes(rules),
+		clients: map[string][]rateLimitPrefix{},
+	}
+
+	for k := range clients {
+		rl.clients[k] = sortRateLimitPrefixes(clients[k])
+	}
+
+	return rl
+}
+
+// SetClientIPHeader sets the header of client IP, like "X-Forwarded-For", when
+// the API nodes are behind the proxy.
+func (rl *RateLimiter) SetClientIPHeader(h string) *RateLimiter {
+	rl.ipHeader = http.CanonicalHeaderKey(h)
+
+	return rl
+}
+
+// SetTrustedProxies sets the number of trusted proxies in front of the API
+// nodes. Each proxy appends the address of its peer to the client IP header,
+// so the client IP is the n-th address from the right; the addresses on the
+// left of it can be forged by the client. Under 1, it is 1.
+func (rl *RateLimiter) SetTrustedProxies(n int) *RateLimiter {
+	rl.trustedProxies = n
+
+	return rl
+}
+
+// Take counts the request; the returned bool is false when no rule is
+// matched.
+func (rl *RateLimiter) Take(r *http.Request) (RateLimitResult, bool, error) {
+	client, isKey := rl.client(r)
+
+	prefix, rule, found := rl.rule(client, r.URL.Path)
+	if !found || rule.Unlimited() {
+		return RateLimitResult{}, false, nil
+	}
+
+	if isKey {
+		h := sha256.Sum256([]byte(client))
+		client = "key:" + hex.EncodeToString(h[:])
+	} else {
+		client = "ip:" + client
+	}
+
+	result, err := rl.store.Take(prefix+"|"+client, rule)
+
+	return result, true, err
+}
+
+// client returns the client of request. When the request was resolved by
+// Authenticator, only the resolved key name is used; the raw key in header is
+// used only without Authenticator and when it is one of the configured API
+// keys, not IP address.
+func (rl *RateLimiter) client(r *http.Request) (string, bool) {
+	if name, resolved := r.Context().Value(APIKeyContextKey).(string); resolved {
+		if len(name) > 0 {
+			return name, true
+		}
+	} else if key := strings.TrimSpace(r.Header.Get(APIKeyHeader)); len(key) > 0 && net.ParseIP(key) == nil {
+		if _, found := rl.clients[key]; found {
+			return key, true
+		}
+	}
+
+	if len(rl.ipHeader) > 0 {
+		if s := rl.forwardedIP(r.Header.Values(rl.ipHeader)); len(s) > 0 {
+			return s, false
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr, false
+	}
+
+	return host, false
+}
+
+// forwardedIP returns the address added by the farthest trusted proxy.
+func (rl *RateLimiter) forwardedIP(values []string) string {
+	var ips []string
+
+	for i := range values {
+		for _, s := range strings.Split(values[i], ",") {
+			if s = strings.TrimSpace(s); len(s) > 0 {
+				ips = append(ips, s)
+			}
+		}
+	}
+
+	if len(ips) < 1 {
+		return ""
+	}
+
+	n := rl.trustedProxies
+	if n < 1 {
+		n = 1
+	}
+
+	if n > len(ips) {
+		return ips[0]
+	}
+
+	return ips[len(ips)-n]
+}
+
+func (rl *RateLimiter) rule(client, path string) (string, RateLimitRule, bool) {
+	if l, found := rl.clients[client]; found {
+		if p, found := matchRateLimitPrefix(l, path); found {
+			return p.prefix, p.rule, true
+		}
+	}
+
+	p, found := matchRateLimitPrefix(rl.rules, path)
+
+	return p.prefix, p.rule, found
+}
+
+func sortRateLimitPrefixes(rules map[string]RateLimitRule) []rateLimitPrefix {
+	l := make([]rateLimitPrefix, 0, len(rules))
+
+	for k := range rules {
+		l = append(l, rateLimitPrefix{prefix: k, rule: rules[k]})
+	}
+
+	sort.Slice(l, func(i, j int) bool {
+		return len(l[i].prefix) > len(l[j].prefix)
+	})
+
+	return l
+}
+
+// matchRateLimitPrefix finds the longest prefix; the prefix matches by path
+// segment, so "/block" does not match "/blocks".
+func matchRateLimitPrefix(l []rateLimitPrefix, path string) (rateLimitPrefix, bool) {
+	for i := range l {
+		prefix := l[i].prefix
+
+		switch {
+		case path == prefix,
+			strings.HasSuffix(prefix, "/") && strings.HasPrefix(path, prefix),
+			strings.HasPrefix(path, prefix+"/"):
+			return l[i], true
+		}
+	}
+
+	return rateLimitPrefix{}, false
+}
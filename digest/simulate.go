@@ -0,0 +1,154 @@
+package digest
+
+import (
+	"context"
+	"io"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// SimulateFunc processes the operation on the current states like it is in
+// the next block, but nothing is stored.
+type SimulateFunc func(context.Context, base.Operation) (SimulateResult, error)
+
+type SimulateStateDiff struct {
+	Key      string          `json:"key"`
+	Previous base.StateValue `json:"previous,omitempty"`
+	Value    base.StateValue `json:"value"`
+}
+
+// SimulateResult is the result of simulation; if the operation fails, Reason
+// has the OperationProcessReasonError and States is empty. Fees is the amount
+// credited to the fee receiver of each currency.
+type SimulateResult struct {
+	Operation mitumutil.Hash                  `json:"operation"`
+	Fact      mitumutil.Hash                  `json:"fact"`
+	Height    base.Height                     `json:"height"`
+	Passed    bool                            `json:"passed"`
+	Reason    string                          `json:"reason,omitempty"`
+	States    []SimulateStateDiff             `json:"states,omitempty"`
+	Fees      map[types.CurrencyID]common.Big `json:"fees,omitempty"`
+}
+
+// SimulateOperation runs PreProcess and Process of opp with op against
+// getStateFunc. opp is closed after simulation.
+func SimulateOperation(
+	ctx context.Context,
+	height base.Height,
+	opp base.OperationProcessor,
+	op base.Operation,
+	getStateFunc base.GetStateFunc,
+) (SimulateResult, error) {
+	if i, ok := opp.(io.Closer); ok {
+		defer func() {
+			_ = i.Close()
+		}()
+	}
+
+	r := SimulateResult{
+		Operation: op.Hash(),
+		Fact:      op.Fact().Hash(),
+		Height:    height,
+	}
+
+	switch nctx, reasonErr, err := opp.PreProcess(ctx, op, getStateFunc); {
+	case err != nil:
+		return r, errors.WithMessage(err, "failed to preprocess operation")
+	case reasonErr != nil:
+		r.Reason = reasonErr.Error()
+
+		return r, nil
+	default:
+		ctx = nctx
+	}
+
+	stmvs, reasonErr, err := opp.Process(ctx, op, getStateFunc)
+	switch {
+	case err != nil:
+		return r, errors.WithMessage(err, "failed to process operation")
+	case reasonErr != nil:
+		r.Reason = reasonErr.Error()
+
+		return r, nil
+	}
+
+	r.Passed = true
+	r.States = make([]SimulateStateDiff, len(stmvs))
+
+	for i := range stmvs {
+		d := SimulateStateDiff{Key: stmvs[i].Key(), Value: stmvs[i].Value()}
+
+		switch st, found, err := getStateFunc(d.Key); {
+		case err != nil:
+			return r, err
+		case found:
+			d.Previous = st.Value()
+		}
+
+		r.States[i] = d
+	}
+
+	fees, err := simulatedFees(r.States, getStateFunc)
+	if err != nil {
+		return r, err
+	}
+	r.Fees = fees
+
+	return r, nil
+}
+
+func simulatedFees(
+	diffs []SimulateStateDiff, getStateFunc base.GetStateFunc,
+) (map[types.CurrencyID]common.Big, error) {
+	fees := map[types.CurrencyID]common.Big{}
+
+	for i := range diffs {
+		d := diffs[i]
+		if !currency.IsStateBalanceKey(d.Key) {
+			continue
+		}
+
+		v, ok := d.Value.(currency.BalanceStateValue)
+		if !ok {
+			continue
+		}
+
+		cid := v.Amount.Currency()
+
+		var receiver base.Address
+
+		switch st, found, err := getStateFunc(currency.StateKeyCurrencyDesign(cid)); {
+		case err != nil:
+			return nil, err
+		case !found:
+			continue
+		default:
+			de, err := currency.StateCurrencyDesignValue(st)
+			if err != nil {
+				return nil, err
+			}
+
+			if fr := de.Policy().Feeer(); fr != nil {
+				receiver = fr.Receiver()
+			}
+		}
+
+		if receiver == nil || d.Key != currency.StateKeyBalance(receiver, cid) {
+			continue
+		}
+
+		fee := v.Amount.Big()
+		if p, ok := d.Previous.(currency.BalanceStateValue); ok {
+			fee = fee.Sub(p.Amount.Big())
+		}
+
+		fees[cid] = fee
+	}
+
+	return fees, nil
+}
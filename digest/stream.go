@@ -0,0 +1,306 @@
+package digest
+
+import (
+	"sync"
+
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/fixedtree"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	// StreamReplayBlocks is the number of the latest blocks, whose events are
+	// kept to be replayed for the reconnected subscribers.
+	StreamReplayBlocks = 10
+	// StreamSubscriberBuffer is the number of events, which a subscriber can
+	// be behind; the slower subscriber is disconnected.
+	StreamSubscriberBuffer = 1 << 10
+)
+
+type StreamEventType string
+
+const (
+	StreamEventManifest  = StreamEventType("manifest")
+	StreamEventOperation = StreamEventType("operation")
+	StreamEventBalance   = StreamEventType("balance")
+)
+
+// StreamEvent is pushed to the subscribers of Streamer, when a block is
+// digested. Index is the order of event in the block.
+type StreamEvent struct {
+	Type       StreamEventType `json:"type"`
+	Height     base.Height     `json:"height"`
+	Index      uint64          `json:"index"`
+	Manifest   base.Manifest   `json:"manifest,omitempty"`
+	Operation  *OperationValue `json:"operation,omitempty"`
+	Address    string          `json:"address,omitempty"`
+	Balance    *types.Amount   `json:"balance,omitempty"`
+	addresses  []string
+	currencies []types.CurrencyID
+	hint       string
+}
+
+// NewStreamEvents makes the events of the digested block; manifest first,
+// operations and then balance changes. The currencies of operation are the
+// currencies of the balances changed by the operation.
+func NewStreamEvents(
+	blk base.BlockMap,
+	ops []base.Operation,
+	opsTree fixedtree.Tree,
+	sts []base.State,
+) ([]StreamEvent, error) {
+	height := blk.Manifest().Height()
+
+	events := []StreamEvent{{Type: StreamEventManifest, Height: height, Manifest: blk.Manifest()}}
+
+	opsCurrencies := map[string][]types.CurrencyID{}
+	opsAddresses := map[string][]string{}
+
+	var balances []StreamEvent
+
+	for i := range sts {
+		st := sts[i]
+		if !statecurrency.IsStateBalanceKey(st.Key()) {
+			continue
+		}
+
+		am, err := statecurrency.StateBalanceValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		address := st.Key()[:len(st.Key())-len(statecurrency.StateKeyBalanceSuffix)-len(am.Currency())-1]
+
+		for _, h := range st.Operations() {
+			opsCurrencies[h.String()] = append(opsCurrencies[h.String()], am.Currency())
+			opsAddresses[h.String()] = append(opsAddresses[h.String()], address)
+		}
+
+		balances = append(balances, StreamEvent{
+			Type:       StreamEventBalance,
+			Height:     height,
+			Address:    address,
+			Balance:    &am,
+			addresses:  []string{address},
+			currencies: []types.CurrencyID{am.Currency()},
+		})
+	}
+
+	nodes := map[string]base.OperationFixedtreeNode{}
+
+	if err := opsTree.Traverse(func(_ uint64, no fixedtree.Node) (bool, error) {
+		nno := no.(base.OperationFixedtreeNode) //nolint:forcetypeassert //...
+		nodes[nno.Key()] = nno
+
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for i := range ops {
+		op := ops[i]
+		fh := op.Fact().Hash().String()
+
+		var inState bool
+		var reason base.OperationProcessReasonError
+
+		if no, found := nodes[fh]; found {
+			inState = no.InState()
+			reason = no.Reason()
+		}
+
+		addresses := opsAddresses[fh]
+
+		if ads, ok := op.Fact().(types.Addresses); ok {
+			as, err := ads.Addresses()
+			if err != nil {
+				return nil, err
+			}
+
+			for j := range as {
+				addresses = append(addresses, as[j].String())
+			}
+		}
+
+		var ht string
+		if hinter, ok := op.(hint.Hinter); ok {
+			ht = hinter.Hint().Type().String()
+		}
+
+		va := NewOperationValue(op, height, blk.SignedAt(), inState, reason, uint64(i))
+
+		events = append(events, StreamEvent{
+			Type:       StreamEventOperation,
+			Height:     height,
+			Operation:  &va,
+			addresses:  addresses,
+			currencies: opsCurrencies[fh],
+			hint:       ht,
+		})
+	}
+
+	events = append(events, balances...)
+
+	for i := range events {
+		events[i].Index = uint64(i)
+	}
+
+	return events, nil
+}
+
+// StreamFilter selects the events for subscriber. The empty field matches
+// everything. Addresses and Currencies are not applied to manifest, and Hints
+// is applied only to operation.
+type StreamFilter struct {
+	Types      map[StreamEventType]struct{}
+	Addresses  map[string]struct{}
+	Currencies map[types.CurrencyID]struct{}
+	Hints      map[string]struct{}
+}
+
+func (f StreamFilter) Match(e StreamEvent) bool {
+	if len(f.Types) > 0 {
+		if _, found := f.Types[e.Type]; !found {
+			return false
+		}
+	}
+
+	if e.Type == StreamEventManifest {
+		return true
+	}
+
+	if len(f.Addresses) > 0 && !matchStreamFilter(f.Addresses, e.addresses) {
+		return false
+	}
+
+	if len(f.Currencies) > 0 && !matchStreamFilter(f.Currencies, e.currencies) {
+		return false
+	}
+
+	if len(f.Hints) > 0 && e.Type == StreamEventOperation {
+		if _, found := f.Hints[e.hint]; !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchStreamFilter[T comparable](m map[T]struct{}, l []T) bool {
+	for i := range l {
+		if _, found := m[l[i]]; found {
+			return true
+		}
+	}
+
+	return false
+}
+
+type streamSubscriber struct {
+	ch     chan StreamEvent
+	filter StreamFilter
+}
+
+// Streamer delivers the events of digested blocks to the subscribers. The
+// events of the latest StreamReplayBlocks blocks are kept, so the subscriber
+// can continue from the last received event after reconnecting.
+type Streamer struct {
+	subs   map[uint64]streamSubscriber
+	replay [][]StreamEvent
+	id     uint64
+	sync.Mutex
+}
+
+func NewStreamer() *Streamer {
+	return &Streamer{
+		subs: map[uint64]streamSubscriber{},
+	}
+}
+
+// Subscribe returns the channel of events after the given height and index;
+// when height is NilHeight, only the new events are delivered. The channel is
+// closed by cancel or when the subscriber is too slow.
+func (s *Streamer) Subscribe(
+	filter StreamFilter, height base.Height, index uint64,
+) (<-chan StreamEvent, func()) {
+	s.Lock()
+	defer s.Unlock()
+
+	ch := make(chan StreamEvent, StreamSubscriberBuffer)
+
+	if height > base.NilHeight {
+		for i := range s.replay {
+			for j := range s.replay[i] {
+				e := s.replay[i][j]
+
+				switch {
+				case e.Height < height, e.Height == height && e.Index <= index:
+					continue
+				case !filter.Match(e):
+					continue
+				}
+
+				select {
+				case ch <- e:
+				default:
+					// NOTE too many events to replay; the subscriber reads
+					// the replayed events and subscribes again.
+					close(ch)
+
+					return ch, func() {}
+				}
+			}
+		}
+	}
+
+	s.id++
+	id := s.id
+
+	s.subs[id] = streamSubscriber{ch: ch, filter: filter}
+
+	return ch, func() {
+		s.Lock()
+		defer s.Unlock()
+
+		if sub, found := s.subs[id]; found {
+			delete(s.subs, id)
+			close(sub.ch)
+		}
+	}
+}
+
+func (s *Streamer) Publish(events []StreamEvent) {
+	if len(events) < 1 {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.replay = append(s.replay, events)
+	if len(s.replay) > StreamReplayBlocks {
+		s.replay = s.replay[len(s.replay)-StreamReplayBlocks:]
+	}
+
+	for id := range s.subs {
+		sub := s.subs[id]
+
+	end:
+		for i := range events {
+			if !sub.filter.Match(events[i]) {
+				continue
+			}
+
+			select {
+			case sub.ch <- events[i]:
+			default:
+				delete(s.subs, id)
+				close(sub.ch)
+
+				break end
+			}
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -93,6 +94,14 @@ func stringCurrencyQuery(currencyId string) string {
 	return fmt.Sprintf("currency=%s", currencyId)
 }
 
+func StringMemoQuery(memo string) string {
+	if len(memo) < 1 {
+		return ""
+	}
+
+	return fmt.Sprintf("memo=%s", url.QueryEscape(memo))
+}
+
 func ParseBoolQuery(s string) bool {
 	return s == "1"
 }
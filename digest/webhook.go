@@ -0,0 +1,296 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+	"github.com/ProtoconNet/mitum2/util/logging"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+var (
+	WebhookSignatureHeader = http.CanonicalHeaderKey("x-mitum-signature")
+	WebhookTimestampHeader = http.CanonicalHeaderKey("x-mitum-timestamp")
+	WebhookDeliveryHeader  = http.CanonicalHeaderKey("x-mitum-delivery")
+	WebhookWorkers         = 4
+	WebhookQueueSize       = 1 << 10
+	WebhookTimeout         = time.Second * 10
+	WebhookBackoff         = time.Second * 2
+)
+
+// Webhook is the subscription of the stream events; the matched events of
+// each digested block are sent to URL at once. Secret is the key of the HMAC
+// signature in WebhookSignatureHeader.
+type Webhook struct {
+	ID         string        `json:"id" bson:"_id"`
+	URL        string        `json:"url" bson:"url"`
+	Secret     string        `json:"secret,omitempty" bson:"secret"`
+	Types      []string      `json:"types,omitempty" bson:"types"`
+	Addresses  []string      `json:"addresses,omitempty" bson:"addresses"`
+	Currencies []string      `json:"currencies,omitempty" bson:"currencies"`
+	Hints      []string      `json:"hints,omitempty" bson:"hints"`
+	CreatedAt  time.Time     `json:"created_at" bson:"created_at"`
+	Status     WebhookStatus `json:"status" bson:"status"`
+}
+
+// WebhookStatus is the delivery status of webhook. The undelivered payloads
+// are not kept; the subscriber can recover the missed events of the failed
+// heights from the digest API.
+type WebhookStatus struct {
+	DeliveredHeight base.Height     `json:"delivered_height" bson:"delivered_height"`
+	DeliveredAt     time.Time       `json:"delivered_at,omitempty" bson:"delivered_at"`
+	Failures        uint64          `json:"failures" bson:"failures"`
+	LastFailure     *WebhookFailure `json:"last_failure,omitempty" bson:"last_failure,omitempty"`
+}
+
+// WebhookFailure is the delivery, which is dropped from the full queue or
+// failed after all the retries.
+type WebhookFailure struct {
+	Delivery string      `json:"delivery" bson:"delivery"`
+	Height   base.Height `json:"height" bson:"height"`
+	Reason   string      `json:"reason" bson:"reason"`
+	FailedAt time.Time   `json:"failed_at" bson:"failed_at"`
+}
+
+// NewWebhookID returns the random id of webhook.
+func NewWebhookID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func (wh Webhook) IsValid([]byte) error {
+	e := mitumutil.ErrInvalid.Errorf("invalid Webhook")
+
+	if len(wh.ID) < 1 {
+		return e.Wrap(errors.Errorf("empty id"))
+	}
+
+	switch u, err := url.Parse(wh.URL); {
+	case err != nil:
+		return e.Wrap(err)
+	case u.Scheme != "http" && u.Scheme != "https", len(u.Host) < 1:
+		return e.Wrap(errors.Errorf("webhook url should be http or https url, %q", wh.URL))
+	}
+
+	if len(wh.Secret) < 1 {
+		return e.Wrap(errors.Errorf("empty secret"))
+	}
+
+	return nil
+}
+
+func (wh Webhook) Filter(enc encoder.Encoder) (StreamFilter, error) {
+	return NewStreamFilter(wh.Types, wh.Addresses, wh.Currencies, wh.Hints, enc)
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of timestamp and body,
+// joined by ".".
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	m := hmac.New(sha256.New, []byte(secret))
+	_, _ = m.Write([]byte(timestamp))
+	_, _ = m.Write([]byte("."))
+	_, _ = m.Write(body)
+
+	return hex.EncodeToString(m.Sum(nil))
+}
+
+type WebhookPayload struct {
+	Webhook string        `json:"webhook"`
+	Height  base.Height   `json:"height"`
+	Events  []StreamEvent `json:"events"`
+}
+
+type webhookDelivery struct {
+	webhook Webhook
+	id      string
+	height  base.Height
+	body    []byte
+}
+
+// WebhookDispatcher sends the stream events of digested blocks to the
+// webhooks in database. The failed delivery is retried with exponential
+// backoff; the result of delivery is recorded in the status of webhook.
+type WebhookDispatcher struct {
+	*logging.Logging
+	database *Database
+	enc      encoder.Encoder
+	client   *http.Client
+	queue    chan webhookDelivery
+	retries  int
+}
+
+func NewWebhookDispatcher(st *Database, enc encoder.Encoder, retries int) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		Logging: logging.NewLogging(func(c zerolog.Context) zerolog.Context {
+			return c.Str("module", "webhook-dispatcher")
+		}),
+		database: st,
+		enc:      enc,
+		client:   &http.Client{Timeout: WebhookTimeout},
+		queue:    make(chan webhookDelivery, WebhookQueueSize),
+		retries:  retries,
+	}
+}
+
+// Start runs the workers until ctx is done.
+func (wd *WebhookDispatcher) Start(ctx context.Context) {
+	for i := 0; i < WebhookWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case d := <-wd.queue:
+					wd.deliver(ctx, d)
+				}
+			}
+		}()
+	}
+}
+
+// Dispatch queues the deliveries of events to the matched webhooks; it does
+// not wait the deliveries.
+func (wd *WebhookDispatcher) Dispatch(events []StreamEvent) {
+	if len(events) < 1 {
+		return
+	}
+
+	whs, err := wd.database.Webhooks()
+	if err != nil {
+		wd.Log().Error().Err(err).Msg("failed to load webhooks")
+
+		return
+	}
+
+	height := events[0].Height
+
+	for i := range whs {
+		wh := whs[i]
+
+		filter, err := wh.Filter(wd.enc)
+		if err != nil {
+			wd.Log().Error().Err(err).Str("webhook", wh.ID).Msg("invalid webhook filter")
+
+			continue
+		}
+
+		var matched []StreamEvent
+
+		for j := range events {
+			if filter.Match(events[j]) {
+				matched = append(matched, events[j])
+			}
+		}
+
+		if len(matched) < 1 {
+			continue
+		}
+
+		body, err := wd.enc.Marshal(WebhookPayload{Webhook: wh.ID, Height: height, Events: matched})
+		if err != nil {
+			wd.Log().Error().Err(err).Str("webhook", wh.ID).Msg("failed to marshal webhook payload")
+
+			continue
+		}
+
+		d := webhookDelivery{webhook: wh, id: fmt.Sprintf("%s-%d", wh.ID, height), height: height, body: body}
+
+		select {
+		case wd.queue <- d:
+		default:
+			wd.Log().Error().Str("webhook", wh.ID).Int64("height", height.Int64()).Msg("webhook queue full; dropped")
+
+			wd.failed(d, errors.Errorf("queue full"))
+		}
+	}
+}
+
+func (wd *WebhookDispatcher) deliver(ctx context.Context, d webhookDelivery) {
+	backoff := WebhookBackoff
+
+	var err error
+
+	for i := 0; i <= wd.retries; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+				backoff *= 2
+			}
+		}
+
+		err = wd.post(ctx, d)
+		if err == nil {
+			if uerr := wd.database.SetWebhookDelivered(d.webhook.ID, d.height); uerr != nil {
+				wd.Log().Error().Err(uerr).Str("delivery", d.id).Msg("failed to update webhook status")
+			}
+
+			return
+		}
+
+		wd.Log().Debug().Err(err).Str("delivery", d.id).Int("tried", i+1).Msg("failed to deliver webhook")
+	}
+
+	wd.Log().Error().Err(err).Str("delivery", d.id).Str("url", d.webhook.URL).Msg("webhook delivery failed")
+
+	wd.failed(d, err)
+}
+
+func (wd *WebhookDispatcher) failed(d webhookDelivery, reason error) {
+	if err := wd.database.SetWebhookFailure(d.webhook.ID, WebhookFailure{
+		Delivery: d.id,
+		Height:   d.height,
+		Reason:   reason.Error(),
+		FailedAt: localtime.Now().UTC(),
+	}); err != nil {
+		wd.Log().Error().Err(err).Str("delivery", d.id).Msg("failed to update webhook status")
+	}
+}
+
+func (wd *WebhookDispatcher) post(ctx context.Context, d webhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.webhook.URL, bytes.NewReader(d.body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	timestamp := strconv.FormatInt(localtime.Now().UTC().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookDeliveryHeader, d.id)
+	req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(d.webhook.Secret, timestamp, d.body))
+
+	res, err := wd.client.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errors.Errorf("unexpected status, %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package batch
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/extension"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	BatchFactHint = hint.MustNewHint("mitum-currency-batch-operation-fact-v0.0.1")
+	BatchHint     = hint.MustNewHint("mitum-currency-batch-operation-v0.0.1")
+)
+
+var MaxBatchItems uint = 10
+
+// BatchFact contains the facts of transfer, create-account, update-key and
+// withdraw. The facts are processed in order and all of them should succeed;
+// otherwise nothing of them is applied.
+type BatchFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []base.Fact
+}
+
+func NewBatchFact(token []byte, sender base.Address, items []base.Fact) BatchFact {
+	bf := base.NewBaseFact(BatchFactHint, token)
+	fact := BatchFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BatchFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BatchFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BatchFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Hash().Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+	)
+}
+
+func (fact BatchFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(MaxBatchItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, MaxBatchItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if it == nil {
+			return util.ErrInvalid.Errorf("empty item, %d", i)
+		}
+
+		if err := it.IsValid(b); err != nil {
+			return util.ErrInvalid.Wrap(errors.Wrapf(err, "invalid item, %d", i))
+		}
+
+		sender, feePayer, err := itemSigners(it)
+		switch {
+		case err != nil:
+			return util.ErrInvalid.Wrap(err)
+		case !sender.Equal(fact.sender):
+			return util.ErrInvalid.Errorf("sender of item, %d is not batch sender, %v", i, sender)
+		case feePayer != nil:
+			return util.ErrInvalid.Errorf("fee payer not allowed in batch item, %d", i)
+		}
+
+		// NOTE the signs of batch are checked by the keys of sender before
+		// processing items; update-key should be the last one not to change
+		// the keys for the other items.
+		if _, ok := it.(currency.UpdateKeyFact); ok && i != len(fact.items)-1 {
+			return util.ErrInvalid.Errorf("update-key should be the last item, %d", i)
+		}
+
+		k := it.Hash().String()
+		if _, found := founds[k]; found {
+			return util.ErrInvalid.Errorf("duplicated item found, %v", it.Hash())
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact BatchFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BatchFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BatchFact) Items() []base.Fact {
+	return fact.items
+}
+
+func (fact BatchFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	for i := range fact.items {
+		j, ok := fact.items[i].(interface {
+			Addresses() ([]base.Address, error)
+		})
+		if !ok {
+			continue
+		}
+
+		ias, err := j.Addresses()
+		if err != nil {
+			return nil, err
+		}
+
+		as = append(as, ias...)
+	}
+
+	return as, nil
+}
+
+type Batch struct {
+	common.BaseOperation
+}
+
+func NewBatch(fact BatchFact) (Batch, error) {
+	return Batch{BaseOperation: common.NewBaseOperation(BatchHint, fact)}, nil
+}
+
+func (op *Batch) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ItemOperations wraps the items of batch into their own operations. The
+// operations have the signs of batch, so the processors of them check the
+// signs like they are signed by themselves.
+func ItemOperations(op base.Operation) ([]base.Operation, error) {
+	fact, ok := op.Fact().(BatchFact)
+	if !ok {
+		return nil, errors.Errorf("expected BatchFact, not %T", op.Fact())
+	}
+
+	ops := make([]base.Operation, len(fact.items))
+	for i := range fact.items {
+		h := valuehash.NewSHA256(util.ConcatByters(op.Hash(), fact.items[i].Hash()))
+
+		switch t := fact.items[i].(type) {
+		case currency.TransferFact:
+			iop, _ := currency.NewTransfer(t)
+			iop.SetSigns(op.Signs())
+			iop.SetHash(h)
+			ops[i] = iop
+		case currency.CreateAccountFact:
+			iop, _ := currency.NewCreateAccount(t)
+			iop.SetSigns(op.Signs())
+			iop.SetHash(h)
+			ops[i] = iop
+		case currency.UpdateKeyFact:
+			iop, _ := currency.NewUpdateKey(t)
+			iop.SetSigns(op.Signs())
+			iop.SetHash(h)
+			ops[i] = iop
+		case extension.WithdrawFact:
+			iop, _ := extension.NewWithdraw(t)
+			iop.SetSigns(op.Signs())
+			iop.SetHash(h)
+			ops[i] = iop
+		default:
+			return nil, errors.Errorf("unsupported batch item, %T", t)
+		}
+	}
+
+	return ops, nil
+}
+
+func itemSigners(fact base.Fact) (sender, feePayer base.Address, _ error) {
+	switch t := fact.(type) {
+	case currency.TransferFact:
+		return t.Sender(), t.FeePayer(), nil
+	case currency.CreateAccountFact:
+		return t.Sender(), t.FeePayer(), nil
+	case currency.UpdateKeyFact:
+		return t.Target(), t.FeePayer(), nil
+	case extension.WithdrawFact:
+		return t.Sender(), t.FeePayer(), nil
+	default:
+		return nil, nil, errors.Errorf("unsupported batch item, %T", t)
+	}
+}
@@ -0,0 +1,37 @@
+package batch
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *BatchFact) unpack(enc encoder.Encoder, sd string, bit []byte) error {
+	e := util.StringError("failed to unmarshal BatchFact")
+
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = ad
+	}
+
+	hit, err := enc.DecodeSlice(bit)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	items := make([]base.Fact, len(hit))
+	for i := range hit {
+		j, ok := hit[i].(base.Fact)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected Fact, not %T", hit[i]))
+		}
+
+		items[i] = j
+	}
+	fact.items = items
+
+	return nil
+}
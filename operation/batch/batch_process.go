@@ -0,0 +1,158 @@
+package batch
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var batchProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BatchProcessor)
+	},
+}
+
+func (Batch) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+// GetNewItemProcessor returns the processor of the item of batch; usually it
+// is the GetNewProcessorFunc of OperationProcessor.
+type GetNewItemProcessor func(base.Operation) (base.OperationProcessor, bool, error)
+
+type BatchProcessor struct {
+	*base.BaseOperationProcessor
+	getNewItemProcessor GetNewItemProcessor
+}
+
+func NewBatchProcessor(getNewItemProcessor GetNewItemProcessor) types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new BatchProcessor")
+
+		nopp := batchProcessorPool.Get()
+		opp, ok := nopp.(*BatchProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected BatchProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getNewItemProcessor = getNewItemProcessor
+
+		return opp, nil
+	}
+}
+
+func (opp *BatchProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(BatchFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected BatchFact, not %T", op.Fact()), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+// Process processes the items in order. Each item sees the states updated by
+// the former items, so the account created by create-account can be funded by
+// the next transfer. If any item fails, no state of batch is merged.
+func (opp *BatchProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	ops, err := ItemOperations(op)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get batch items; %w", err), nil
+	}
+
+	var stmvs []base.StateMergeValue
+	updated := map[string]int{}
+
+	igetStateFunc := func(key string) (base.State, bool, error) {
+		if i, found := updated[key]; found {
+			return common.NewBaseState(opp.Height(), key, stmvs[i].Value(), nil, nil), true, nil
+		}
+
+		return getStateFunc(key)
+	}
+
+	for i := range ops {
+		var iopp base.OperationProcessor
+
+		switch j, known, err := opp.getNewItemProcessor(ops[i]); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to get processor of item, %d; %w", i, err), nil
+		case !known:
+			return nil, base.NewBaseOperationProcessReasonError("unknown item, %d, %T", i, ops[i]), nil
+		default:
+			iopp = j
+		}
+
+		switch _, reasonErr, err := iopp.PreProcess(ctx, ops[i], igetStateFunc); {
+		case err != nil:
+			return nil, nil, errors.WithMessagef(err, "failed to preprocess item, %d", i)
+		case reasonErr != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to preprocess item, %d; %w", i, reasonErr), nil
+		}
+
+		ist, reasonErr, err := iopp.Process(ctx, ops[i], igetStateFunc)
+		switch {
+		case err != nil:
+			return nil, nil, errors.WithMessagef(err, "failed to process item, %d", i)
+		case reasonErr != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to process item, %d; %w", i, reasonErr), nil
+		}
+
+		// NOTE the value of state is replaced by merging, so the later value
+		// of the same key, which is based on the former one, is kept.
+		for j := range ist {
+			k := ist[j].Key()
+			if l, found := updated[k]; found {
+				stmvs[l] = ist[j]
+
+				continue
+			}
+
+			updated[k] = len(stmvs)
+			stmvs = append(stmvs, ist[j])
+		}
+	}
+
+	return stmvs, nil, nil
+}
+
+func (opp *BatchProcessor) Close() error {
+	opp.getNewItemProcessor = nil
+	batchProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,5 @@
+/*
+Package batch provides the operation, which processes the facts of the different
+operations at once.
+*/
+package batch
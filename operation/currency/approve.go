@@ -0,0 +1,173 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ApproveFactHint = hint.MustNewHint("mitum-currency-approve-operation-fact-v0.0.1")
+	ApproveHint     = hint.MustNewHint("mitum-currency-approve-operation-v0.0.1")
+)
+
+// ApproveFact sets the allowance of spender over the balance of sender. The
+// previous allowance is replaced, and zero amount removes it.
+type ApproveFact struct {
+	base.BaseFact
+	sender      base.Address
+	spender     base.Address
+	amount      types.Amount
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewApproveFact(token []byte, sender, spender base.Address, amount types.Amount) ApproveFact {
+	bf := base.NewBaseFact(ApproveFactHint, token)
+	fact := ApproveFact{
+		BaseFact: bf,
+		sender:   sender,
+		spender:  spender,
+		amount:   amount,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.spender.Bytes(),
+		fact.amount.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact ApproveFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.spender, fact.amount); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.spender) {
+		return util.ErrInvalid.Errorf("spender is same with sender, %v", fact.sender)
+	}
+
+	if !fact.amount.Big().OverNil() {
+		return util.ErrInvalid.Errorf("allowance amount under zero")
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact ApproveFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveFact) Spender() base.Address {
+	return fact.spender
+}
+
+func (fact ApproveFact) Amount() types.Amount {
+	return fact.amount
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact ApproveFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact ApproveFact) SetFeeCurrency(cid types.CurrencyID) ApproveFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact ApproveFact) SetFeePayer(feePayer base.Address) ApproveFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.spender}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type Approve struct {
+	common.BaseOperation
+}
+
+func NewApprove(fact ApproveFact) (Approve, error) {
+	return Approve{BaseOperation: common.NewBaseOperation(ApproveHint, fact)}, nil
+}
+
+func (op *Approve) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact ApproveFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        fact.Hint().String(),
+			"sender":       fact.sender,
+			"spender":      fact.spender,
+			"amount":       fact.amount,
+			"fee_currency": fact.feeCurrency,
+			"fee_payer":    fact.feePayer,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
+		},
+	)
+}
+
+type ApproveFactBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Sender      string   `bson:"sender"`
+	Spender     string   `bson:"spender"`
+	Amount      bson.Raw `bson:"amount"`
+	FeeCurrency string   `bson:"fee_currency"`
+	FeePayer    string   `bson:"fee_payer"`
+}
+
+func (fact *ApproveFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ApproveFact")
+
+	var u common.BaseFactBSONUnmarshaler
+
+	err := enc.Unmarshal(b, &u)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(u.Hash))
+	fact.BaseFact.SetToken(u.Token)
+
+	var uf ApproveFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Spender, uf.Amount, uf.FeeCurrency, uf.FeePayer)
+}
+
+func (op Approve) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *Approve) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Approve")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
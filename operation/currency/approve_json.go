@@ -0,0 +1,76 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ApproveFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	Spender     base.Address     `json:"spender"`
+	Amount      types.Amount     `json:"amount"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact ApproveFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Spender:               fact.spender,
+		Amount:                fact.amount,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type ApproveFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Spender     string          `json:"spender"`
+	Amount      json.RawMessage `json:"amount"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
+}
+
+func (fact *ApproveFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ApproveFact")
+
+	var uf ApproveFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Spender, uf.Amount, uf.FeeCurrency, uf.FeePayer)
+}
+
+type approveMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Approve) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(approveMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Approve) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode Approve")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveProcessor)
+	},
+}
+
+func (Approve) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ApproveProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewApproveProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ApproveProcessor")
+
+		nopp := approveProcessorPool.Get()
+		opp, ok := nopp.(*ApproveProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected ApproveProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ApproveFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected ApproveFact, not %T", op.Fact()), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.spender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of spender %v; %w", fact.spender, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyCurrencyDesign(fact.amount.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", fact.amount.Currency(), err), nil
+	}
+
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(ApproveFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected ApproveFact, not %T", op.Fact()), nil
+	}
+
+	cid := fact.amount.Currency()
+
+	// NOTE the fee of approve is charged like the transfer of zero amount.
+	feeReceiveBalSts, required, err := CalculateItemsFeeWithFeeCurrency(
+		getStateFunc, []AmountsItem{amountsItem{types.NewZeroAmount(cid)}}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	senderBalSts, feePayerBalSts, err := CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	stmvs, err := PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+
+	stmvs = append(stmvs, state.NewStateMergeValue(
+		currency.StateKeyAllowance(fact.sender, fact.spender, cid),
+		currency.NewAllowanceStateValue(fact.sender, fact.spender, fact.amount),
+	))
+
+	return stmvs, nil, nil
+}
+
+func (opp *ApproveProcessor) Close() error {
+	approveProcessorPool.Put(opp)
+
+	return nil
+}
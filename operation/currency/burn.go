@@ -0,0 +1,168 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	BurnFactHint = hint.MustNewHint("mitum-currency-burn-operation-fact-v0.0.1")
+	BurnHint     = hint.MustNewHint("mitum-currency-burn-operation-v0.0.1")
+)
+
+// BurnFact removes amount from the balance of sender and from the aggregate
+// of the currency.
+type BurnFact struct {
+	base.BaseFact
+	sender      base.Address
+	amount      types.Amount
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewBurnFact(token []byte, sender base.Address, amount types.Amount) BurnFact {
+	bf := base.NewBaseFact(BurnFactHint, token)
+	fact := BurnFact{
+		BaseFact: bf,
+		sender:   sender,
+		amount:   amount,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BurnFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BurnFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BurnFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.amount.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact BurnFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.amount); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("burn amount should be over zero")
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact BurnFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BurnFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BurnFact) Amount() types.Amount {
+	return fact.amount
+}
+
+// Amounts implements AmountsItem, so the fee of burn is calculated like the
+// items of the other operations.
+func (fact BurnFact) Amounts() []types.Amount {
+	return []types.Amount{fact.amount}
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact BurnFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact BurnFact) SetFeeCurrency(cid types.CurrencyID) BurnFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BurnFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact BurnFact) SetFeePayer(feePayer base.Address) BurnFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BurnFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type Burn struct {
+	common.BaseOperation
+}
+
+func NewBurn(fact BurnFact) (Burn, error) {
+	return Burn{BaseOperation: common.NewBaseOperation(BurnHint, fact)}, nil
+}
+
+func (op *Burn) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type BurnFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	Amount      types.Amount     `json:"amount"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact BurnFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BurnFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Amount:                fact.amount,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type BurnFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Amount      json.RawMessage `json:"amount"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
+}
+
+func (fact *BurnFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of BurnFact")
+
+	var uf BurnFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Amount, uf.FeeCurrency, uf.FeePayer)
+}
+
+type burnMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Burn) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(burnMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Burn) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode Burn")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var burnProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BurnProcessor)
+	},
+}
+
+func (Burn) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type BurnProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewBurnProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new BurnProcessor")
+
+		nopp := burnProcessorPool.Get()
+		opp, ok := nopp.(*BurnProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected BurnProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *BurnProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(BurnFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected BurnFact, not %T", op.Fact()), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckNotExistsState(extension.StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot burn amounts, %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyCurrencyDesign(fact.amount.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", fact.amount.Currency(), err), nil
+	}
+
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *BurnProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(BurnFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BurnFact, not %T", op.Fact()), nil
+	}
+
+	feeReceiveBalSts, required, err := CalculateItemsFeeWithFeeCurrency(getStateFunc, []AmountsItem{fact}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	senderBalSts, feePayerBalSts, err := CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	stmvs, err := PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+
+	cid := fact.amount.Currency()
+
+	st, err := state.ExistsState(currency.StateKeyCurrencyDesign(cid), "currency design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", cid, err), nil
+	}
+
+	de, err := currency.StateCurrencyDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get currency design value, %v; %w", cid, err), nil
+	}
+
+	ade, err := de.SubAggregate(fact.amount.Big())
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to remove aggregate, %v; %w", cid, err), nil
+	}
+
+	stmvs = append(stmvs, state.NewStateMergeValue(st.Key(), currency.NewCurrencyDesignStateValue(ade)))
+
+	return stmvs, nil, nil
+}
+
+func (opp *BurnProcessor) Close() error {
+	burnProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CancelStandingOrderFactHint = hint.MustNewHint("mitum-currency-cancel-standing-order-operation-fact-v0.0.1")
+	CancelStandingOrderHint     = hint.MustNewHint("mitum-currency-cancel-standing-order-operation-v0.0.1")
+)
+
+// CancelStandingOrderFact cancels the standing order, id of sender; the
+// payments, which are not paid yet, are not paid anymore.
+type CancelStandingOrderFact struct {
+	base.BaseFact
+	sender base.Address
+	id     types.StandingOrderID
+}
+
+func NewCancelStandingOrderFact(
+	token []byte, sender base.Address, id types.StandingOrderID,
+) CancelStandingOrderFact {
+	bf := base.NewBaseFact(CancelStandingOrderFactHint, token)
+	fact := CancelStandingOrderFact{
+		BaseFact: bf,
+		sender:   sender,
+		id:       id,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CancelStandingOrderFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CancelStandingOrderFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelStandingOrderFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.id.Bytes(),
+	)
+}
+
+func (fact CancelStandingOrderFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.id)
+}
+
+func (fact CancelStandingOrderFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CancelStandingOrderFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CancelStandingOrderFact) ID() types.StandingOrderID {
+	return fact.id
+}
+
+func (fact CancelStandingOrderFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type CancelStandingOrder struct {
+	common.BaseOperation
+}
+
+func NewCancelStandingOrder(fact CancelStandingOrderFact) (CancelStandingOrder, error) {
+	return CancelStandingOrder{BaseOperation: common.NewBaseOperation(CancelStandingOrderHint, fact)}, nil
+}
+
+func (op *CancelStandingOrder) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
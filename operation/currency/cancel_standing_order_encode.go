@@ -0,0 +1,23 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *CancelStandingOrderFact) unpack(enc encoder.Encoder, sd, id string) error {
+	e := util.StringError("failed to unmarshal CancelStandingOrderFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	fact.id = types.StandingOrderID(id)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type CancelStandingOrderFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address          `json:"sender"`
+	ID     types.StandingOrderID `json:"id"`
+}
+
+func (fact CancelStandingOrderFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CancelStandingOrderFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		ID:                    fact.id,
+	})
+}
+
+type CancelStandingOrderFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string `json:"sender"`
+	ID     string `json:"id"`
+}
+
+func (fact *CancelStandingOrderFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of CancelStandingOrderFact")
+
+	var uf CancelStandingOrderFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.ID)
+}
+
+type cancelStandingOrderMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op CancelStandingOrder) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(cancelStandingOrderMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CancelStandingOrder) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode CancelStandingOrder")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
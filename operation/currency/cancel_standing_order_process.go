@@ -0,0 +1,146 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var cancelStandingOrderProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelStandingOrderProcessor)
+	},
+}
+
+func (CancelStandingOrder) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CancelStandingOrderProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCancelStandingOrderProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new CancelStandingOrderProcessor")
+
+		nopp := cancelStandingOrderProcessorPool.Get()
+		opp, ok := nopp.(*CancelStandingOrderProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected CancelStandingOrderProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CancelStandingOrderProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(CancelStandingOrderFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected CancelStandingOrderFact, not %T", op.Fact()), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if _, err := existsStandingOrder(fact.sender, fact.id, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check standing order; %w", err), nil
+	}
+
+	if err := state.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CancelStandingOrderProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(CancelStandingOrderFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected CancelStandingOrderFact, not %T", op.Fact()), nil
+	}
+
+	sv, err := existsStandingOrder(fact.sender, fact.id, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check standing order; %w", err), nil
+	}
+
+	// NOTE the fee of cancellation is charged like the transfer of zero
+	// amount.
+	feeReceiveBalSts, required, err := CalculateItemsFee(
+		getStateFunc, []AmountsItem{amountsItem{types.NewZeroAmount(sv.Amount.Currency())}})
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	senderBalSts, err := CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	stmvs, err := PayRequired(senderBalSts, nil, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+
+	k := currency.StateKeyStandingOrder(fact.sender, fact.id)
+
+	// NOTE the cancelled order is left in the due orders and dropped when its
+	// due height is paid.
+	return append(stmvs, currency.NewStandingOrderCancelMergeValue(k)), nil, nil
+}
+
+// existsStandingOrder returns the standing order, which is not finished.
+func existsStandingOrder(
+	sender base.Address, id types.StandingOrderID, getStateFunc base.GetStateFunc,
+) (currency.StandingOrderStateValue, error) {
+	st, err := state.ExistsState(currency.StateKeyStandingOrder(sender, id), "standing order", getStateFunc)
+	if err != nil {
+		return currency.StandingOrderStateValue{}, errors.WithMessagef(err, "standing order, %v, %v", sender, id)
+	}
+
+	sv, err := currency.StateStandingOrderValue(st)
+	if err != nil {
+		return currency.StandingOrderStateValue{}, err
+	}
+
+	if sv.Finished() {
+		return currency.StandingOrderStateValue{}, errors.Errorf("standing order already finished, %v, %v", sender, id)
+	}
+
+	return sv, nil
+}
+
+func (opp *CancelStandingOrderProcessor) Close() error {
+	cancelStandingOrderProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ClaimVestingFactHint = hint.MustNewHint("mitum-currency-claim-vesting-operation-fact-v0.0.1")
+	ClaimVestingHint     = hint.MustNewHint("mitum-currency-claim-vesting-operation-v0.0.1")
+)
+
+// ClaimVestingFact releases the vested amount of currency, locked by grantor,
+// into the balance of sender. The fee is charged like the transfer of zero
+// amount of currency.
+type ClaimVestingFact struct {
+	base.BaseFact
+	sender      base.Address
+	grantor     base.Address
+	currency    types.CurrencyID
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewClaimVestingFact(
+	token []byte, sender, grantor base.Address, currency types.CurrencyID,
+) ClaimVestingFact {
+	bf := base.NewBaseFact(ClaimVestingFactHint, token)
+	fact := ClaimVestingFact{
+		BaseFact: bf,
+		sender:   sender,
+		grantor:  grantor,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimVestingFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ClaimVestingFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ClaimVestingFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.grantor.Bytes(),
+		fact.currency.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact ClaimVestingFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.grantor, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.grantor) {
+		return util.ErrInvalid.Errorf("grantor is same with sender, %v", fact.sender)
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact ClaimVestingFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ClaimVestingFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ClaimVestingFact) Grantor() base.Address {
+	return fact.grantor
+}
+
+func (fact ClaimVestingFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact ClaimVestingFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact ClaimVestingFact) SetFeeCurrency(cid types.CurrencyID) ClaimVestingFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimVestingFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact ClaimVestingFact) SetFeePayer(feePayer base.Address) ClaimVestingFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimVestingFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.grantor}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type ClaimVesting struct {
+	common.BaseOperation
+}
+
+func NewClaimVesting(fact ClaimVestingFact) (ClaimVesting, error) {
+	return ClaimVesting{BaseOperation: common.NewBaseOperation(ClaimVestingHint, fact)}, nil
+}
+
+func (op *ClaimVesting) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
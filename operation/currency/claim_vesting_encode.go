@@ -0,0 +1,41 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ClaimVestingFact) unpack(enc encoder.Encoder, sd, gt, cid, fc, fp string) error {
+	e := util.StringError("failed to unmarshal ClaimVestingFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(gt, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.grantor = a
+	}
+
+	fact.currency = types.CurrencyID(cid)
+
+	fact.feeCurrency = types.CurrencyID(fc)
+
+	if len(fp) > 0 {
+		switch a, err := base.DecodeAddress(fp, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			fact.feePayer = a
+		}
+	}
+
+	return nil
+}
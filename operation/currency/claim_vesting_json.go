@@ -0,0 +1,74 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ClaimVestingFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	Grantor     base.Address     `json:"grantor"`
+	Currency    types.CurrencyID `json:"currency"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact ClaimVestingFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ClaimVestingFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Grantor:               fact.grantor,
+		Currency:              fact.currency,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type ClaimVestingFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string `json:"sender"`
+	Grantor     string `json:"grantor"`
+	Currency    string `json:"currency"`
+	FeeCurrency string `json:"fee_currency"`
+	FeePayer    string `json:"fee_payer"`
+}
+
+func (fact *ClaimVestingFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ClaimVestingFact")
+
+	var uf ClaimVestingFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Grantor, uf.Currency, uf.FeeCurrency, uf.FeePayer)
+}
+
+type claimVestingMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ClaimVesting) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(claimVestingMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ClaimVesting) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode ClaimVesting")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
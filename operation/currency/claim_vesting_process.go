@@ -0,0 +1,164 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var claimVestingProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ClaimVestingProcessor)
+	},
+}
+
+func (ClaimVesting) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ClaimVestingProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewClaimVestingProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ClaimVestingProcessor")
+
+		nopp := claimVestingProcessorPool.Get()
+		opp, ok := nopp.(*ClaimVestingProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected ClaimVestingProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ClaimVestingProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ClaimVestingFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected ClaimVestingFact, not %T", op.Fact()), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckNotExistsState(extension.StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot claim vesting, %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyCurrencyDesign(fact.currency), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", fact.currency, err), nil
+	}
+
+	if err := state.CheckExistsState(
+		currency.StateKeyVesting(fact.sender, fact.grantor, fact.currency), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"vesting not found, %v, %v, %v; %w", fact.grantor, fact.sender, fact.currency, err), nil
+	}
+
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ClaimVestingProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(ClaimVestingFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected ClaimVestingFact, not %T", op.Fact()), nil
+	}
+
+	vst, err := state.ExistsState(
+		currency.StateKeyVesting(fact.sender, fact.grantor, fact.currency), "vesting", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"vesting not found, %v, %v, %v; %w", fact.grantor, fact.sender, fact.currency, err), nil
+	}
+
+	v, err := currency.StateVestingValue(vst)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get vesting value, %v; %w", fact.sender, err), nil
+	}
+
+	claimable := v.Claimable(opp.Height())
+	if !claimable.OverZero() {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"nothing to claim at height %v, %v, %v", opp.Height(), fact.sender, fact.currency), nil
+	}
+
+	payer := fact.sender
+	if fact.feePayer != nil {
+		payer = fact.feePayer
+	}
+
+	// NOTE the fee is charged like the transfer of zero amount of currency.
+	feeReceiveBalSts, required, err := CalculateItemsFeeWithFeeCurrency(
+		getStateFunc, []AmountsItem{amountsItem{types.NewZeroAmount(fact.currency)}}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	payerBalSts, err := CheckEnoughBalance(payer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee payer balance, %v; %w", payer, err), nil
+	}
+
+	stmvs, err := PayRequired(payerBalSts, nil, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+
+	k := currency.StateKeyBalance(fact.sender, fact.currency)
+	if stmvs, err = creditBalance(stmvs, k, fact.currency, claimable, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to credit sender balance, %v; %w", k, err), nil
+	}
+
+	stmvs = append(stmvs, state.NewStateMergeValue(vst.Key(), currency.NewVestingStateValue(
+		v.Sender, v.Receiver, v.Amount, v.Claimed.Add(claimable), v.Start, v.Cliff, v.End,
+	)))
+
+	return stmvs, nil, nil
+}
+
+func (opp *ClaimVestingProcessor) Close() error {
+	claimVestingProcessorPool.Put(opp)
+
+	return nil
+}
@@ -29,12 +29,15 @@ type CreateAccountItem interface {
 	Address() (base.Address, error)
 	Rebuild() CreateAccountItem
 	AddressType() hint.Type
+	Memo() string
 }
 
 type CreateAccountFact struct {
 	base.BaseFact
-	sender base.Address
-	items  []CreateAccountItem
+	sender      base.Address
+	items       []CreateAccountItem
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
 }
 
 func NewCreateAccountFact(
@@ -67,10 +70,22 @@ func (fact CreateAccountFact) Bytes() []byte {
 		is[i] = fact.items[i].Bytes()
 	}
 
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(is...),
+		fc,
+		fp,
 	)
 }
 
@@ -93,6 +108,12 @@ func (fact CreateAccountFact) IsValid(b []byte) error {
 		return err
 	}
 
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	foundKeys := map[string]struct{}{}
 	for i := range fact.items {
 		it := fact.items[i]
@@ -115,6 +136,16 @@ func (fact CreateAccountFact) IsValid(b []byte) error {
 		}
 	}
 
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
 	return nil
 }
 
@@ -130,6 +161,19 @@ func (fact CreateAccountFact) Items() []CreateAccountItem {
 	return fact.items
 }
 
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of each amount.
+func (fact CreateAccountFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact CreateAccountFact) SetFeeCurrency(cid types.CurrencyID) CreateAccountFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
 func (fact CreateAccountFact) Targets() ([]base.Address, error) {
 	as := make([]base.Address, len(fact.items))
 	for i := range fact.items {
@@ -154,9 +198,24 @@ func (fact CreateAccountFact) Addresses() ([]base.Address, error) {
 
 	as[len(fact.items)] = fact.Sender()
 
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
+func (fact CreateAccountFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact CreateAccountFact) SetFeePayer(feePayer base.Address) CreateAccountFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
 func (fact CreateAccountFact) Rebuild() CreateAccountFact {
 	items := make([]CreateAccountItem, len(fact.items))
 	for i := range fact.items {
@@ -1,13 +1,14 @@
 package currency
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/pkg/errors"
 )
 
-func (fact *CreateAccountFact) unpack(enc encoder.Encoder, sd string, bit []byte) error {
+func (fact *CreateAccountFact) unpack(enc encoder.Encoder, sd string, bit []byte, fc, fp string) error {
 	e := util.StringError("failed to unmarshal CreateAccountFact")
 
 	switch ad, err := base.DecodeAddress(sd, enc); {
@@ -32,6 +33,16 @@ func (fact *CreateAccountFact) unpack(enc encoder.Encoder, sd string, bit []byte
 		items[i] = j
 	}
 	fact.items = items
+	fact.feeCurrency = types.CurrencyID(fc)
+
+	if len(fp) > 0 {
+		switch a, err := base.DecodeAddress(fp, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			fact.feePayer = a
+		}
+	}
 
 	return nil
 }
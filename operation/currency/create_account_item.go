@@ -12,6 +12,7 @@ type BaseCreateAccountItem struct {
 	keys        types.AccountKeys
 	amounts     []types.Amount
 	addressType hint.Type
+	memo        string
 }
 
 func NewBaseCreateAccountItem(ht hint.Hint, keys types.AccountKeys, amounts []types.Amount, addrHint hint.Type) BaseCreateAccountItem {
@@ -31,6 +32,10 @@ func (it BaseCreateAccountItem) Bytes() []byte {
 		bs[i+2] = it.amounts[i].Bytes()
 	}
 
+	if len(it.memo) > 0 {
+		bs = append(bs, []byte(it.memo))
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -47,6 +52,10 @@ func (it BaseCreateAccountItem) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid AddressHint")
 	}
 
+	if err := types.IsValidMemo(it.memo); err != nil {
+		return err
+	}
+
 	founds := map[types.CurrencyID]struct{}{}
 	for i := range it.amounts {
 		am := it.amounts[i]
@@ -86,6 +95,10 @@ func (it BaseCreateAccountItem) Amounts() []types.Amount {
 	return it.amounts
 }
 
+func (it BaseCreateAccountItem) Memo() string {
+	return it.memo
+}
+
 func (it BaseCreateAccountItem) Rebuild() CreateAccountItem {
 	ams := make([]types.Amount, len(it.amounts))
 	for i := range it.amounts {
@@ -14,6 +14,7 @@ func (it BaseCreateAccountItem) MarshalBSON() ([]byte, error) {
 			"keys":     it.keys,
 			"amounts":  it.amounts,
 			"addrtype": it.addressType,
+			"memo":     it.memo,
 		},
 	)
 }
@@ -23,6 +24,7 @@ type CreateAccountItemBSONUnmarshaler struct {
 	Keys     bson.Raw `bson:"keys"`
 	Amount   bson.Raw `bson:"amounts"`
 	AddrType string   `bson:"addrtype"`
+	Memo     string   `bson:"memo"`
 }
 
 func (it *BaseCreateAccountItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -38,5 +40,5 @@ func (it *BaseCreateAccountItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) erro
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, ht, uit.Keys, uit.Amount, uit.AddrType)
+	return it.unpack(enc, ht, uit.Keys, uit.Amount, uit.AddrType, uit.Memo)
 }
@@ -8,7 +8,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (it *BaseCreateAccountItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bam []byte, sadtype string) error {
+func (it *BaseCreateAccountItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bam []byte, sadtype, memo string) error {
 	e := util.StringError("failed to unmarshal BaseCreateAccountItem")
 
 	it.BaseHinter = hint.NewBaseHinter(ht)
@@ -38,6 +38,7 @@ func (it *BaseCreateAccountItem) unpack(enc encoder.Encoder, ht hint.Hint, bks [
 
 	it.amounts = amounts
 	it.addressType = hint.Type(sadtype)
+	it.memo = memo
 
 	return nil
 }
@@ -14,6 +14,7 @@ type CreateAccountItemJSONMarshaler struct {
 	Keys     types.AccountKeys `json:"keys"`
 	Amounts  []types.Amount    `json:"amounts"`
 	AddrType hint.Type         `json:"addrtype"`
+	Memo     string            `json:"memo,omitempty"`
 }
 
 func (it BaseCreateAccountItem) MarshalJSON() ([]byte, error) {
@@ -22,6 +23,7 @@ func (it BaseCreateAccountItem) MarshalJSON() ([]byte, error) {
 		Keys:       it.keys,
 		Amounts:    it.amounts,
 		AddrType:   it.addressType,
+		Memo:       it.memo,
 	})
 }
 
@@ -30,6 +32,7 @@ type CreateAccountItemJSONUnMarshaler struct {
 	Keys     json.RawMessage `json:"keys"`
 	Amounts  json.RawMessage `json:"amounts"`
 	AddrType string          `json:"addrtype"`
+	Memo     string          `json:"memo"`
 }
 
 func (it *BaseCreateAccountItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -40,5 +43,5 @@ func (it *BaseCreateAccountItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) erro
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, uit.Hint, uit.Keys, uit.Amounts, uit.AddrType)
+	return it.unpack(enc, uit.Hint, uit.Keys, uit.Amounts, uit.AddrType, uit.Memo)
 }
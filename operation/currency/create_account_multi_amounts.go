@@ -39,3 +39,9 @@ func (it CreateAccountItemMultiAmounts) Rebuild() CreateAccountItem {
 
 	return it
 }
+
+func (it CreateAccountItemMultiAmounts) SetMemo(memo string) CreateAccountItemMultiAmounts {
+	it.memo = memo
+
+	return it
+}
@@ -59,6 +59,11 @@ func (opp *CreateAccountItemProcessor) PreProcess(
 			return base.NewBaseOperationProcessReasonError(
 				"amount should be over minimum balance, %v < %v", am.Big(), policy.NewAccountMinBalance())
 		}
+
+		if n := uint(len(opp.item.Memo())); n > policy.MaxMemoSize() {
+			return base.NewBaseOperationProcessReasonError(
+				"memo over max size of currency, %v, %d > %d", am.Currency(), n, policy.MaxMemoSize())
+		}
 	}
 
 	target, err := opp.item.Address()
@@ -184,7 +189,13 @@ func (opp *CreateAccountProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be create-account sender, %v; %w", fact.Sender(), err), nil
 	}
 
-	if err := state.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
 	}
 
@@ -218,14 +229,14 @@ func (opp *CreateAccountProcessor) Process( // nolint:dupl
 	}
 
 	var (
-		senderBalSts, feeReceiveBalSts map[types.CurrencyID]base.State
-		required                       map[types.CurrencyID][2]common.Big
-		err                            error
+		senderBalSts, feePayerBalSts, feeReceiveBalSts map[types.CurrencyID]base.State
+		required                                       map[types.CurrencyID][2]common.Big
+		err                                            error
 	)
 
 	if feeReceiveBalSts, required, err = opp.calculateItemsFee(op, getStateFunc); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
-	} else if senderBalSts, err = CheckEnoughBalance(fact.sender, required, getStateFunc); err != nil {
+	} else if senderBalSts, feePayerBalSts, err = CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, getStateFunc); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender %v ; %w", fact.sender, err), nil
 	} else {
 		opp.required = required
@@ -259,39 +270,12 @@ func (opp *CreateAccountProcessor) Process( // nolint:dupl
 		stateMergeValues = append(stateMergeValues, s...)
 	}
 
-	for cid := range senderBalSts {
-		v, ok := senderBalSts[cid].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSts[cid].Value()), nil
-		}
-
-		var stateMergeValue base.StateMergeValue
-		if senderBalSts[cid].Key() == feeReceiveBalSts[cid].Key() {
-			stateMergeValue = state.NewStateMergeValue(
-				senderBalSts[cid].Key(),
-				currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[cid][0]).Add(opp.required[cid][1]))),
-			)
-		} else {
-			stateMergeValue = state.NewStateMergeValue(
-				senderBalSts[cid].Key(),
-				currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[cid][0]))),
-			)
-			r, ok := feeReceiveBalSts[cid].Value().(currency.BalanceStateValue)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", feeReceiveBalSts[cid].Value()), nil
-			}
-			stateMergeValues = append(
-				stateMergeValues,
-				state.NewStateMergeValue(
-					feeReceiveBalSts[cid].Key(),
-					currency.NewBalanceStateValue(r.Amount.WithBig(r.Amount.Big().Add(opp.required[cid][1]))),
-				),
-			)
-		}
-		stateMergeValues = append(stateMergeValues, stateMergeValue)
+	sts, err := PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, opp.required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
 	}
 
-	return stateMergeValues, nil, nil
+	return append(stateMergeValues, sts...), nil, nil
 }
 
 func (opp *CreateAccountProcessor) Close() error {
@@ -321,7 +305,20 @@ func (opp *CreateAccountProcessor) calculateItemsFee(
 		items[i] = fact.items[i]
 	}
 
-	return CalculateItemsFee(getStateFunc, items)
+	feeReceiveSts, required, err := CalculateItemsFee(getStateFunc, items)
+	if err != nil || len(fact.feeCurrency) < 1 {
+		return feeReceiveSts, required, err
+	}
+
+	return ConvertItemsFee(getStateFunc, fact.feeCurrency, feeReceiveSts, required)
+}
+
+// amountsItem is the AmountsItem for the amounts, which are not in the items of
+// operation.
+type amountsItem []types.Amount
+
+func (ams amountsItem) Amounts() []types.Amount {
+	return ams
 }
 
 func CalculateItemsFee(getStateFunc base.GetStateFunc, items []AmountsItem) (map[types.CurrencyID]base.State, map[types.CurrencyID][2]common.Big, error) {
@@ -373,6 +370,95 @@ func CalculateItemsFee(getStateFunc base.GetStateFunc, items []AmountsItem) (map
 	return feeReceiveSts, required, nil
 }
 
+// ConvertItemsFee moves the fees in required to feeCurrency. Each fee is
+// converted by the fee rate from its currency to feeCurrency, rounding up, and
+// is paid to the feeer receiver of feeCurrency.
+func ConvertItemsFee(
+	getStateFunc base.GetStateFunc,
+	feeCurrency types.CurrencyID,
+	feeReceiveSts map[types.CurrencyID]base.State,
+	required map[types.CurrencyID][2]common.Big,
+) (map[types.CurrencyID]base.State, map[types.CurrencyID][2]common.Big, error) {
+	policy, err := state.ExistsCurrencyPolicy(feeCurrency, getStateFunc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	receiver := policy.Feeer().Receiver()
+	if receiver == nil {
+		return nil, nil, base.NewBaseOperationProcessReasonError("feeer receiver of fee currency not found, %v", feeCurrency)
+	}
+
+	fee := common.ZeroBig
+	for cid := range required {
+		rq := required[cid]
+		if cid == feeCurrency || !rq[1].OverZero() {
+			continue
+		}
+
+		rate, err := state.ExistsFeeRate(cid, feeCurrency, getStateFunc)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		k := rate.Convert(rq[1])
+		if !k.OverZero() {
+			return nil, nil, base.NewBaseOperationProcessReasonError(
+				"fee converted to zero, %v of %v to %v", rq[1], cid, feeCurrency)
+		}
+
+		fee = fee.Add(k)
+
+		// NOTE the currency, which has only the fee, like the fee of zero
+		// amount, is not required anymore.
+		if am := rq[0].Sub(rq[1]); am.OverZero() {
+			required[cid] = [2]common.Big{am, common.ZeroBig}
+		} else {
+			delete(required, cid)
+		}
+	}
+
+	if !fee.OverZero() {
+		return feeReceiveSts, required, nil
+	}
+
+	rq := [2]common.Big{common.ZeroBig, common.ZeroBig}
+	if k, found := required[feeCurrency]; found {
+		rq = k
+	}
+	required[feeCurrency] = [2]common.Big{rq[0].Add(fee), rq[1].Add(fee)}
+
+	if _, found := feeReceiveSts[feeCurrency]; !found {
+		if err := state.CheckExistsState(currency.StateKeyAccount(receiver), getStateFunc); err != nil {
+			return nil, nil, err
+		} else if st, found, err := getStateFunc(currency.StateKeyBalance(receiver, feeCurrency)); err != nil {
+			return nil, nil, err
+		} else if !found {
+			return nil, nil, errors.Errorf("feeer receiver %s not found", receiver)
+		} else {
+			feeReceiveSts[feeCurrency] = st
+		}
+	}
+
+	return feeReceiveSts, required, nil
+}
+
+// CalculateItemsFeeWithFeeCurrency calculates the fees of items like
+// CalculateItemsFee. If feeCurrency is not empty, the fees are converted to
+// feeCurrency by ConvertItemsFee.
+func CalculateItemsFeeWithFeeCurrency(
+	getStateFunc base.GetStateFunc,
+	items []AmountsItem,
+	feeCurrency types.CurrencyID,
+) (map[types.CurrencyID]base.State, map[types.CurrencyID][2]common.Big, error) {
+	feeReceiveSts, required, err := CalculateItemsFee(getStateFunc, items)
+	if err != nil || len(feeCurrency) < 1 {
+		return feeReceiveSts, required, err
+	}
+
+	return ConvertItemsFee(getStateFunc, feeCurrency, feeReceiveSts, required)
+}
+
 func CheckEnoughBalance(
 	holder base.Address,
 	required map[types.CurrencyID][2]common.Big,
@@ -383,6 +469,10 @@ func CheckEnoughBalance(
 	for cid := range required {
 		rq := required[cid]
 
+		if err := state.CheckNotFrozen(holder, cid, getStateFunc); err != nil {
+			return nil, err
+		}
+
 		st, err := state.ExistsState(currency.StateKeyBalance(holder, cid), "currency of holder", getStateFunc)
 		if err != nil {
 			return nil, err
@@ -402,3 +492,154 @@ func CheckEnoughBalance(
 
 	return sbSts, nil
 }
+
+// CheckFeePayer checks that feePayer is an existing account which can sign,
+// not a contract account.
+func CheckFeePayer(feePayer base.Address, getStateFunc base.GetStateFunc) error {
+	if err := state.CheckExistsState(currency.StateKeyAccount(feePayer), getStateFunc); err != nil {
+		return base.NewBaseOperationProcessReasonError("fee payer not found, %v; %w", feePayer, err)
+	}
+
+	if err := state.CheckNotExistsState(extension.StateKeyContractAccount(feePayer), getStateFunc); err != nil {
+		return base.NewBaseOperationProcessReasonError("contract account cannot be fee payer, %v; %w", feePayer, err)
+	}
+
+	return nil
+}
+
+// CheckEnoughBalanceWithFeePayer checks the balances like CheckEnoughBalance.
+// If feePayer is not nil, the amounts of required are checked against holder
+// and the fees against feePayer.
+func CheckEnoughBalanceWithFeePayer(
+	holder, feePayer base.Address,
+	required map[types.CurrencyID][2]common.Big,
+	getStateFunc base.GetStateFunc,
+) (map[types.CurrencyID]base.State, map[types.CurrencyID]base.State, error) {
+	if feePayer == nil {
+		sbSts, err := CheckEnoughBalance(holder, required, getStateFunc)
+
+		return sbSts, nil, err
+	}
+
+	holderRequired := map[types.CurrencyID][2]common.Big{}
+	feePayerRequired := map[types.CurrencyID][2]common.Big{}
+
+	for cid := range required {
+		rq := required[cid]
+
+		if am := rq[0].Sub(rq[1]); am.OverZero() {
+			holderRequired[cid] = [2]common.Big{am, common.ZeroBig}
+		}
+
+		if rq[1].OverZero() {
+			feePayerRequired[cid] = [2]common.Big{rq[1], rq[1]}
+		}
+	}
+
+	sbSts, err := CheckEnoughBalance(holder, holderRequired, getStateFunc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fbSts, err := CheckEnoughBalance(feePayer, feePayerRequired, getStateFunc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sbSts, fbSts, nil
+}
+
+// PayRequired returns the balance state merge values which debit required from
+// the holder balances and credit the fees to the fee receivers. The fee of the
+// currency found in feePayerBalSts is debited from the fee payer instead.
+func PayRequired(
+	holderBalSts, feePayerBalSts, feeReceiveBalSts map[types.CurrencyID]base.State,
+	required map[types.CurrencyID][2]common.Big,
+) ([]base.StateMergeValue, error) {
+	var keys []string
+	sts := map[string]base.State{}
+	deltas := map[string]common.Big{}
+
+	add := func(st base.State, big common.Big) {
+		k := st.Key()
+		if _, found := deltas[k]; !found {
+			keys = append(keys, k)
+			sts[k] = st
+			deltas[k] = common.ZeroBig
+		}
+
+		deltas[k] = deltas[k].Add(big)
+	}
+
+	for cid := range required {
+		rq := required[cid]
+
+		if st, found := feePayerBalSts[cid]; found {
+			add(st, rq[1].Neg())
+
+			if st, found := holderBalSts[cid]; found {
+				add(st, rq[0].Sub(rq[1]).Neg())
+			}
+		} else if st, found := holderBalSts[cid]; found {
+			add(st, rq[0].Neg())
+		}
+
+		if st, found := feeReceiveBalSts[cid]; found && rq[1].OverZero() {
+			add(st, rq[1])
+		}
+	}
+
+	stmvs := make([]base.StateMergeValue, len(keys))
+	for i, k := range keys {
+		v, ok := sts[k].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, errors.Errorf("expected BalanceStateValue, not %T", sts[k].Value())
+		}
+
+		stmvs[i] = state.NewStateMergeValue(k, currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(deltas[k]))))
+	}
+
+	return stmvs, nil
+}
+
+// creditBalance adds big to the balance of key. If the balance is already
+// updated in stmvs, like the balance of fee payer or fee receiver, it is
+// updated in place.
+func creditBalance(
+	stmvs []base.StateMergeValue,
+	key string,
+	cid types.CurrencyID,
+	big common.Big,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	for i := range stmvs {
+		if stmvs[i].Key() != key {
+			continue
+		}
+
+		v, ok := stmvs[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, errors.Errorf("expected BalanceStateValue, not %T", stmvs[i].Value())
+		}
+
+		stmvs[i] = state.NewStateMergeValue(key, currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(big))))
+
+		return stmvs, nil
+	}
+
+	var ab types.Amount
+	switch st, found, err := getStateFunc(key); {
+	case err != nil:
+		return nil, err
+	case !found:
+		ab = types.NewZeroAmount(cid)
+	default:
+		b, err := currency.StateBalanceValue(st)
+		if err != nil {
+			return nil, err
+		}
+		ab = b
+	}
+
+	return append(stmvs, state.NewStateMergeValue(key, currency.NewBalanceStateValue(ab.WithBig(ab.Big().Add(big))))), nil
+}
@@ -37,3 +37,9 @@ func (it CreateAccountItemSingleAmount) Rebuild() CreateAccountItem {
 
 	return it
 }
+
+func (it CreateAccountItemSingleAmount) SetMemo(memo string) CreateAccountItemSingleAmount {
+	it.memo = memo
+
+	return it
+}
@@ -0,0 +1,205 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CreateVestingFactHint = hint.MustNewHint("mitum-currency-create-vesting-operation-fact-v0.0.1")
+	CreateVestingHint     = hint.MustNewHint("mitum-currency-create-vesting-operation-v0.0.1")
+)
+
+// CreateVestingFact locks amount of sender for receiver. The locked amount is
+// released linearly from start to end height and the receiver can claim the
+// released amount after cliff height by ClaimVesting.
+type CreateVestingFact struct {
+	base.BaseFact
+	sender      base.Address
+	receiver    base.Address
+	amount      types.Amount
+	start       base.Height
+	cliff       base.Height
+	end         base.Height
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewCreateVestingFact(
+	token []byte,
+	sender, receiver base.Address,
+	amount types.Amount,
+	start, cliff, end base.Height,
+) CreateVestingFact {
+	bf := base.NewBaseFact(CreateVestingFactHint, token)
+	fact := CreateVestingFact{
+		BaseFact: bf,
+		sender:   sender,
+		receiver: receiver,
+		amount:   amount,
+		start:    start,
+		cliff:    cliff,
+		end:      end,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateVestingFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CreateVestingFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CreateVestingFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.start.Bytes(),
+		fact.cliff.Bytes(),
+		fact.end.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact CreateVestingFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.receiver, fact.amount); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("vesting amount should be over zero")
+	}
+
+	if err := types.IsValidVestingSchedule(fact.start, fact.cliff, fact.end); err != nil {
+		return err
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact CreateVestingFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CreateVestingFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CreateVestingFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact CreateVestingFact) Amount() types.Amount {
+	return fact.amount
+}
+
+// Amounts implements AmountsItem for the fee of vesting amount.
+func (fact CreateVestingFact) Amounts() []types.Amount {
+	return []types.Amount{fact.amount}
+}
+
+func (fact CreateVestingFact) Start() base.Height {
+	return fact.start
+}
+
+func (fact CreateVestingFact) Cliff() base.Height {
+	return fact.cliff
+}
+
+func (fact CreateVestingFact) End() base.Height {
+	return fact.end
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact CreateVestingFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact CreateVestingFact) SetFeeCurrency(cid types.CurrencyID) CreateVestingFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateVestingFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact CreateVestingFact) SetFeePayer(feePayer base.Address) CreateVestingFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateVestingFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.receiver}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type CreateVesting struct {
+	common.BaseOperation
+}
+
+func NewCreateVesting(fact CreateVestingFact) (CreateVesting, error) {
+	return CreateVesting{BaseOperation: common.NewBaseOperation(CreateVestingHint, fact)}, nil
+}
+
+func (op *CreateVesting) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
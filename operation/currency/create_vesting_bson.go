@@ -0,0 +1,92 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact CreateVestingFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        fact.Hint().String(),
+			"sender":       fact.sender,
+			"receiver":     fact.receiver,
+			"amount":       fact.amount,
+			"start":        fact.start,
+			"cliff":        fact.cliff,
+			"end":          fact.end,
+			"fee_currency": fact.feeCurrency,
+			"fee_payer":    fact.feePayer,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
+		},
+	)
+}
+
+type CreateVestingFactBSONUnmarshaler struct {
+	Hint        string      `bson:"_hint"`
+	Sender      string      `bson:"sender"`
+	Receiver    string      `bson:"receiver"`
+	Amount      bson.Raw    `bson:"amount"`
+	Start       base.Height `bson:"start"`
+	Cliff       base.Height `bson:"cliff"`
+	End         base.Height `bson:"end"`
+	FeeCurrency string      `bson:"fee_currency"`
+	FeePayer    string      `bson:"fee_payer"`
+}
+
+func (fact *CreateVestingFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CreateVestingFact")
+
+	var u common.BaseFactBSONUnmarshaler
+
+	err := enc.Unmarshal(b, &u)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(u.Hash))
+	fact.BaseFact.SetToken(u.Token)
+
+	var uf CreateVestingFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Receiver, uf.Amount, uf.Start, uf.Cliff, uf.End, uf.FeeCurrency, uf.FeePayer)
+}
+
+func (op CreateVesting) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *CreateVesting) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CreateVesting")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
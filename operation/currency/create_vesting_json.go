@@ -0,0 +1,85 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type CreateVestingFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	Receiver    base.Address     `json:"receiver"`
+	Amount      types.Amount     `json:"amount"`
+	Start       base.Height      `json:"start"`
+	Cliff       base.Height      `json:"cliff"`
+	End         base.Height      `json:"end"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact CreateVestingFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CreateVestingFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Receiver:              fact.receiver,
+		Amount:                fact.amount,
+		Start:                 fact.start,
+		Cliff:                 fact.cliff,
+		End:                   fact.end,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type CreateVestingFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Receiver    string          `json:"receiver"`
+	Amount      json.RawMessage `json:"amount"`
+	Start       base.Height     `json:"start"`
+	Cliff       base.Height     `json:"cliff"`
+	End         base.Height     `json:"end"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
+}
+
+func (fact *CreateVestingFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of CreateVestingFact")
+
+	var uf CreateVestingFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Receiver, uf.Amount, uf.Start, uf.Cliff, uf.End, uf.FeeCurrency, uf.FeePayer)
+}
+
+type createVestingMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op CreateVesting) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(createVestingMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CreateVesting) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode CreateVesting")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var createVestingProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CreateVestingProcessor)
+	},
+}
+
+func (CreateVesting) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CreateVestingProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCreateVestingProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new CreateVestingProcessor")
+
+		nopp := createVestingProcessorPool.Get()
+		opp, ok := nopp.(*CreateVestingProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected CreateVestingProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CreateVestingProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(CreateVestingFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected CreateVestingFact, not %T", op.Fact()), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckNotExistsState(extension.StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot create vesting, %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.receiver), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of receiver %v; %w", fact.receiver, err), nil
+	}
+
+	switch st, found, err := getStateFunc(
+		currency.StateKeyVesting(fact.receiver, fact.sender, fact.amount.Currency())); {
+	case err != nil:
+		return ctx, base.NewBaseOperationProcessReasonError("failed to get vesting of receiver %v; %w", fact.receiver, err), nil
+	case found:
+		v, err := currency.StateVestingValue(st)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("failed to get vesting value of receiver %v; %w", fact.receiver, err), nil
+		}
+
+		// NOTE only the vesting from the same sender blocks; vestings from
+		// other senders are kept under their own keys.
+		if v.Locked().OverZero() {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"vesting from sender not claimed yet, %v, %v, %v", fact.sender, fact.receiver, fact.amount.Currency()), nil
+		}
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyCurrencyDesign(fact.amount.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", fact.amount.Currency(), err), nil
+	}
+
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CreateVestingProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(CreateVestingFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected CreateVestingFact, not %T", op.Fact()), nil
+	}
+
+	feeReceiveBalSts, required, err := CalculateItemsFeeWithFeeCurrency(getStateFunc, []AmountsItem{fact}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	senderBalSts, feePayerBalSts, err := CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	stmvs, err := PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+
+	stmvs = append(stmvs, state.NewStateMergeValue(
+		currency.StateKeyVesting(fact.receiver, fact.sender, fact.amount.Currency()),
+		currency.NewVestingStateValue(
+			fact.sender, fact.receiver, fact.amount, common.ZeroBig, fact.start, fact.cliff, fact.end,
+		),
+	))
+
+	return stmvs, nil, nil
+}
+
+func (opp *CreateVestingProcessor) Close() error {
+	createVestingProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	FreezeFactHint = hint.MustNewHint("mitum-currency-freeze-operation-fact-v0.0.1")
+	FreezeHint     = hint.MustNewHint("mitum-currency-freeze-operation-v0.0.1")
+)
+
+// FreezeFact freezes or unfreezes the balance of currency of account. The
+// frozen balance can not be debited until it is unfrozen.
+type FreezeFact struct {
+	base.BaseFact
+	account  base.Address
+	currency types.CurrencyID
+	frozen   bool
+}
+
+func NewFreezeFact(
+	token []byte, account base.Address, currency types.CurrencyID, frozen bool,
+) FreezeFact {
+	fact := FreezeFact{
+		BaseFact: base.NewBaseFact(FreezeFactHint, token),
+		account:  account,
+		currency: currency,
+		frozen:   frozen,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FreezeFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FreezeFact) Bytes() []byte {
+	fb := []byte{0}
+	if fact.frozen {
+		fb = []byte{1}
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.account.Bytes(),
+		fact.currency.Bytes(),
+		fb,
+	)
+}
+
+func (fact FreezeFact) IsValid(b []byte) error {
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.account, fact.currency); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %v", err)
+	}
+
+	return nil
+}
+
+func (fact FreezeFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FreezeFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FreezeFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact FreezeFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact FreezeFact) Frozen() bool {
+	return fact.frozen
+}
+
+func (fact FreezeFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.account}, nil
+}
+
+type Freeze struct {
+	common.BaseNodeOperation
+}
+
+func NewFreeze(fact FreezeFact) (Freeze, error) {
+	return Freeze{
+		BaseNodeOperation: common.NewBaseNodeOperation(FreezeHint, fact),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *FreezeFact) unpack(enc encoder.Encoder, ac, cid string, frozen bool) error {
+	e := util.StringError("failed to unmarshal FreezeFact")
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.account = a
+	}
+
+	fact.currency = types.CurrencyID(cid)
+	fact.frozen = frozen
+
+	return nil
+}
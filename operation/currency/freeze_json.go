@@ -0,0 +1,68 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type FreezeFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Account  base.Address     `json:"account"`
+	Currency types.CurrencyID `json:"currency"`
+	Frozen   bool             `json:"frozen"`
+}
+
+func (fact FreezeFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FreezeFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Account:               fact.account,
+		Currency:              fact.currency,
+		Frozen:                fact.frozen,
+	})
+}
+
+type FreezeFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Account  string `json:"account"`
+	Currency string `json:"currency"`
+	Frozen   bool   `json:"frozen"`
+}
+
+func (fact *FreezeFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of FreezeFact")
+
+	var uf FreezeFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Account, uf.Currency, uf.Frozen)
+}
+
+type freezeMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Freeze) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(freezeMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Freeze) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode Freeze")
+
+	var ubo common.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
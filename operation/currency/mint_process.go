@@ -108,6 +108,33 @@ func (opp *MintProcessor) PreProcess(
 		}
 	}
 
+	aggs := map[types.CurrencyID]common.Big{}
+	for i := range fact.Items() {
+		am := fact.Items()[i].Amount()
+		if big, found := aggs[am.Currency()]; found {
+			aggs[am.Currency()] = big.Add(am.Big())
+		} else {
+			aggs[am.Currency()] = am.Big()
+		}
+	}
+
+	for cid, big := range aggs {
+		st, err := state.ExistsState(currency.StateKeyCurrencyDesign(cid), "currency design", getStateFunc)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %v", cid, err.Error()), nil
+		}
+
+		de, err := currency.StateCurrencyDesignValue(st)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("failed to get currency design value, %v; %v", cid, err.Error()), nil
+		}
+
+		if agg := de.Aggregate().Add(big); de.Policy().IsOverMaxSupply(agg) {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"mint over max supply of currency, %v, %v > %v", cid, agg, de.Policy().MaxSupply()), nil
+		}
+	}
+
 	return ctx, nil, nil
 }
 
@@ -171,6 +198,11 @@ func (opp *MintProcessor) Process(
 			de = d
 		}
 
+		if agg := de.Aggregate().Add(big); de.Policy().IsOverMaxSupply(agg) {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"mint over max supply of currency, %v, %v > %v", cid, agg, de.Policy().MaxSupply()), nil
+		}
+
 		ade, err := de.AddAggregate(big)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("failed to add aggregate, %v; %w", cid, err), nil
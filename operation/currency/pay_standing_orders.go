@@ -0,0 +1,78 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	PayStandingOrdersFactHint = hint.MustNewHint("mitum-currency-pay-standing-orders-operation-fact-v0.0.1")
+	PayStandingOrdersHint     = hint.MustNewHint("mitum-currency-pay-standing-orders-operation-v0.0.1")
+)
+
+// PayStandingOrdersFact pays the due standing orders. The fact is generated by
+// the suffrage nodes for each height, so the token is the height and the facts
+// of the nodes for the same height have the same hash.
+type PayStandingOrdersFact struct {
+	base.BaseFact
+	height base.Height
+}
+
+func NewPayStandingOrdersFact(height base.Height) PayStandingOrdersFact {
+	fact := PayStandingOrdersFact{
+		BaseFact: base.NewBaseFact(PayStandingOrdersFactHint, height.Bytes()),
+		height:   height,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact PayStandingOrdersFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact PayStandingOrdersFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.height.Bytes(),
+	)
+}
+
+func (fact PayStandingOrdersFact) IsValid(b []byte) error {
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := fact.height.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %v", err)
+	}
+
+	return nil
+}
+
+func (fact PayStandingOrdersFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact PayStandingOrdersFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact PayStandingOrdersFact) Height() base.Height {
+	return fact.height
+}
+
+type PayStandingOrders struct {
+	common.BaseNodeOperation
+}
+
+func NewPayStandingOrders(fact PayStandingOrdersFact) (PayStandingOrders, error) {
+	return PayStandingOrders{
+		BaseNodeOperation: common.NewBaseNodeOperation(PayStandingOrdersHint, fact),
+	}, nil
+}
@@ -0,0 +1,9 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func (fact *PayStandingOrdersFact) unpack(height base.Height) {
+	fact.height = height
+}
@@ -0,0 +1,63 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type PayStandingOrdersFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Height base.Height `json:"height"`
+}
+
+func (fact PayStandingOrdersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PayStandingOrdersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Height:                fact.height,
+	})
+}
+
+type PayStandingOrdersFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Height base.Height `json:"height"`
+}
+
+func (fact *PayStandingOrdersFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of PayStandingOrdersFact")
+
+	var uf PayStandingOrdersFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	fact.unpack(uf.Height)
+
+	return nil
+}
+
+type payStandingOrdersMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op PayStandingOrders) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(payStandingOrdersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *PayStandingOrders) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode PayStandingOrders")
+
+	var ubo common.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
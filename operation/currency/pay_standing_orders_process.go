@@ -0,0 +1,149 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var payStandingOrdersProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(PayStandingOrdersProcessor)
+	},
+}
+
+func (PayStandingOrders) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type PayStandingOrdersProcessor struct {
+	*base.BaseOperationProcessor
+	suffrage base.Suffrage
+}
+
+func NewPayStandingOrdersProcessor() types.GetNewProcessor {
+	return func(height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new PayStandingOrdersProcessor")
+
+		nopp := payStandingOrdersProcessorPool.Get()
+		opp, ok := nopp.(*PayStandingOrdersProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected PayStandingOrdersProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		switch i, found, err := getStateFunc(isaac.SuffrageStateKey); {
+		case err != nil:
+			return nil, e.Wrap(err)
+		case !found, i == nil:
+			return nil, e.Wrap(isaac.ErrStopProcessingRetry.Errorf("empty state"))
+		default:
+			sufstv := i.Value().(base.SuffrageNodesStateValue) //nolint:forcetypeassert //...
+
+			suf, err := sufstv.Suffrage()
+			if err != nil {
+				return nil, e.Wrap(isaac.ErrStopProcessingRetry.Errorf("failed to get suffrage from state"))
+			}
+
+			opp.suffrage = suf
+		}
+
+		return opp, nil
+	}
+}
+
+func (opp *PayStandingOrdersProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess for PayStandingOrders")
+
+	nop, ok := op.(PayStandingOrders)
+	if !ok {
+		return ctx, nil, e.Errorf("not PayStandingOrders, %T", op)
+	}
+
+	// NOTE the operation is generated by a suffrage node, so the sign of one
+	// suffrage node is enough; the payments do not depend on the signer.
+	signs := nop.NodeSigns()
+
+	var signed bool
+
+	for i := range signs {
+		if opp.suffrage.ExistsPublickey(signs[i].Node(), signs[i].Signer()) {
+			signed = true
+
+			break
+		}
+	}
+
+	if !signed {
+		return ctx, base.NewBaseOperationProcessReasonError("not signed by suffrage node"), nil
+	}
+
+	fact, ok := op.Fact().(PayStandingOrdersFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not PayStandingOrdersFact, %T", op.Fact())
+	}
+
+	if fact.height > opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"height over current height, %v > %v", fact.height, opp.Height()), nil
+	}
+
+	switch cursor, found, err := standingOrderCursor(getStateFunc); {
+	case err != nil:
+		return ctx, nil, e.Wrap(err)
+	case !found:
+		return ctx, base.NewBaseOperationProcessReasonError("no standing orders"), nil
+	case cursor >= opp.Height():
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"standing orders already paid until %v", cursor), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *PayStandingOrdersProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process PayStandingOrders")
+
+	if _, ok := op.Fact().(PayStandingOrdersFact); !ok {
+		return nil, nil, e.Errorf("not PayStandingOrdersFact, %T", op.Fact())
+	}
+
+	stmvs, err := PayDueStandingOrders(opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, nil, e.Wrap(err)
+	}
+
+	return stmvs, nil, nil
+}
+
+func (opp *PayStandingOrdersProcessor) Close() error {
+	opp.suffrage = nil
+
+	payStandingOrdersProcessorPool.Put(opp)
+
+	return nil
+}
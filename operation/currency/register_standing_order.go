@@ -0,0 +1,216 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RegisterStandingOrderFactHint = hint.MustNewHint("mitum-currency-register-standing-order-operation-fact-v0.0.1")
+	RegisterStandingOrderHint     = hint.MustNewHint("mitum-currency-register-standing-order-operation-v0.0.1")
+)
+
+// RegisterStandingOrderFact registers the payments of amount from sender to
+// receiver, which are due at start, start+interval, ... for count times. The
+// due payments are paid at each height by the operation processor, so sender
+// does not need to sign each payment; the order can be cancelled by
+// CancelStandingOrder.
+type RegisterStandingOrderFact struct {
+	base.BaseFact
+	sender      base.Address
+	id          types.StandingOrderID
+	receiver    base.Address
+	amount      types.Amount
+	start       base.Height
+	interval    uint64
+	count       uint64
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewRegisterStandingOrderFact(
+	token []byte,
+	sender base.Address,
+	id types.StandingOrderID,
+	receiver base.Address,
+	amount types.Amount,
+	start base.Height,
+	interval, count uint64,
+) RegisterStandingOrderFact {
+	bf := base.NewBaseFact(RegisterStandingOrderFactHint, token)
+	fact := RegisterStandingOrderFact{
+		BaseFact: bf,
+		sender:   sender,
+		id:       id,
+		receiver: receiver,
+		amount:   amount,
+		start:    start,
+		interval: interval,
+		count:    count,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RegisterStandingOrderFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RegisterStandingOrderFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RegisterStandingOrderFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.id.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.start.Bytes(),
+		util.Uint64ToBytes(fact.interval),
+		util.Uint64ToBytes(fact.count),
+		fc,
+		fp,
+	)
+}
+
+func (fact RegisterStandingOrderFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.id, fact.receiver, fact.amount); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return util.ErrInvalid.Errorf("receiver is same with sender, %v", fact.sender)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("amount should be over zero")
+	}
+
+	if err := types.IsValidStandingOrderSchedule(fact.start, fact.interval, fact.count); err != nil {
+		return err
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact RegisterStandingOrderFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RegisterStandingOrderFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RegisterStandingOrderFact) ID() types.StandingOrderID {
+	return fact.id
+}
+
+func (fact RegisterStandingOrderFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact RegisterStandingOrderFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact RegisterStandingOrderFact) Start() base.Height {
+	return fact.start
+}
+
+func (fact RegisterStandingOrderFact) Interval() uint64 {
+	return fact.interval
+}
+
+func (fact RegisterStandingOrderFact) Count() uint64 {
+	return fact.count
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact RegisterStandingOrderFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact RegisterStandingOrderFact) SetFeeCurrency(cid types.CurrencyID) RegisterStandingOrderFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RegisterStandingOrderFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact RegisterStandingOrderFact) SetFeePayer(feePayer base.Address) RegisterStandingOrderFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RegisterStandingOrderFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.receiver}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type RegisterStandingOrder struct {
+	common.BaseOperation
+}
+
+func NewRegisterStandingOrder(fact RegisterStandingOrderFact) (RegisterStandingOrder, error) {
+	return RegisterStandingOrder{BaseOperation: common.NewBaseOperation(RegisterStandingOrderHint, fact)}, nil
+}
+
+func (op *RegisterStandingOrder) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact RegisterStandingOrderFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        fact.Hint().String(),
+			"sender":       fact.sender,
+			"id":           fact.id,
+			"receiver":     fact.receiver,
+			"amount":       fact.amount,
+			"start":        fact.start,
+			"interval":     fact.interval,
+			"count":        fact.count,
+			"fee_currency": fact.feeCurrency,
+			"fee_payer":    fact.feePayer,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
+		},
+	)
+}
+
+type RegisterStandingOrderFactBSONUnmarshaler struct {
+	Hint        string      `bson:"_hint"`
+	Sender      string      `bson:"sender"`
+	ID          string      `bson:"id"`
+	Receiver    string      `bson:"receiver"`
+	Amount      bson.Raw    `bson:"amount"`
+	Start       base.Height `bson:"start"`
+	Interval    uint64      `bson:"interval"`
+	Count       uint64      `bson:"count"`
+	FeeCurrency string      `bson:"fee_currency"`
+	FeePayer    string      `bson:"fee_payer"`
+}
+
+func (fact *RegisterStandingOrderFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RegisterStandingOrderFact")
+
+	var u common.BaseFactBSONUnmarshaler
+
+	err := enc.Unmarshal(b, &u)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(u.Hash))
+	fact.BaseFact.SetToken(u.Token)
+
+	var uf RegisterStandingOrderFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.ID, uf.Receiver, uf.Amount, uf.Start, uf.Interval, uf.Count, uf.FeeCurrency, uf.FeePayer)
+}
+
+func (op RegisterStandingOrder) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *RegisterStandingOrder) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RegisterStandingOrder")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RegisterStandingOrderFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address          `json:"sender"`
+	ID          types.StandingOrderID `json:"id"`
+	Receiver    base.Address          `json:"receiver"`
+	Amount      types.Amount          `json:"amount"`
+	Start       base.Height           `json:"start"`
+	Interval    uint64                `json:"interval"`
+	Count       uint64                `json:"count"`
+	FeeCurrency types.CurrencyID      `json:"fee_currency,omitempty"`
+	FeePayer    base.Address          `json:"fee_payer,omitempty"`
+}
+
+func (fact RegisterStandingOrderFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RegisterStandingOrderFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		ID:                    fact.id,
+		Receiver:              fact.receiver,
+		Amount:                fact.amount,
+		Start:                 fact.start,
+		Interval:              fact.interval,
+		Count:                 fact.count,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type RegisterStandingOrderFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	ID          string          `json:"id"`
+	Receiver    string          `json:"receiver"`
+	Amount      json.RawMessage `json:"amount"`
+	Start       base.Height     `json:"start"`
+	Interval    uint64          `json:"interval"`
+	Count       uint64          `json:"count"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
+}
+
+func (fact *RegisterStandingOrderFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RegisterStandingOrderFact")
+
+	var uf RegisterStandingOrderFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.ID, uf.Receiver, uf.Amount, uf.Start, uf.Interval, uf.Count, uf.FeeCurrency, uf.FeePayer)
+}
+
+type registerStandingOrderMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RegisterStandingOrder) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(registerStandingOrderMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RegisterStandingOrder) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode RegisterStandingOrder")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
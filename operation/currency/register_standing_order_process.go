@@ -0,0 +1,170 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var registerStandingOrderProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RegisterStandingOrderProcessor)
+	},
+}
+
+func (RegisterStandingOrder) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type RegisterStandingOrderProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRegisterStandingOrderProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RegisterStandingOrderProcessor")
+
+		nopp := registerStandingOrderProcessorPool.Get()
+		opp, ok := nopp.(*RegisterStandingOrderProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected RegisterStandingOrderProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RegisterStandingOrderProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RegisterStandingOrderFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected RegisterStandingOrderFact, not %T", op.Fact()), nil
+	}
+
+	if fact.start <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"start height should be over current height, %v <= %v", fact.start, opp.Height()), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckNotExistsState(extension.StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"contract account cannot register standing order, %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.receiver), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of receiver %v; %w", fact.receiver, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyCurrencyDesign(fact.amount.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", fact.amount.Currency(), err), nil
+	}
+
+	// NOTE the finished standing order can be registered again with the same
+	// id.
+	switch st, found, err := getStateFunc(currency.StateKeyStandingOrder(fact.sender, fact.id)); {
+	case err != nil:
+		return ctx, nil, err
+	case found:
+		if sv, err := currency.StateStandingOrderValue(st); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("failed to get standing order value; %w", err), nil
+		} else if !sv.Finished() {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"standing order already exists, %v, %v", fact.sender, fact.id), nil
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RegisterStandingOrderProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(RegisterStandingOrderFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected RegisterStandingOrderFact, not %T", op.Fact()), nil
+	}
+
+	cid := fact.amount.Currency()
+
+	// NOTE the fee of registration is charged like the transfer of zero
+	// amount; the fee of each payment is charged to sender when it is paid.
+	feeReceiveBalSts, required, err := CalculateItemsFeeWithFeeCurrency(
+		getStateFunc, []AmountsItem{amountsItem{types.NewZeroAmount(cid)}}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	senderBalSts, feePayerBalSts, err := CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	stmvs, err := PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+
+	k := currency.StateKeyStandingOrder(fact.sender, fact.id)
+
+	stmvs = append(stmvs,
+		currency.NewStandingOrderMergeValue(k, currency.NewStandingOrderStateValue(
+			fact.sender, fact.receiver, fact.amount, fact.start, fact.interval, fact.count, 0)),
+		currency.NewStandingOrderDueMergeValue(fact.start, []string{k}, nil),
+	)
+
+	// NOTE the first registration starts the cursor of due payments; the
+	// payments are made from the next height.
+	switch _, found, err := getStateFunc(currency.StateKeyStandingOrderCursor); {
+	case err != nil:
+		return nil, nil, err
+	case !found:
+		stmvs = append(stmvs, currency.NewStandingOrderCursorMergeValue(opp.Height()))
+	}
+
+	return stmvs, nil, nil
+}
+
+func (opp *RegisterStandingOrderProcessor) Close() error {
+	registerStandingOrderProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package currency
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// PayDueStandingOrders pays the standing orders, which are due from the next
+// height of the cursor until height. Each payment is charged the fee like the
+// single transfer from the sender of order, so the missed payments are not
+// cheaper than the payments paid in time. The order, which the sender can not
+// pay, is cancelled.
+//
+// At most MaxStandingOrderScanHeights due heights and
+// MaxStandingOrderPaymentsPerHeight orders are processed; the cursor is moved
+// to the last height, which the due orders are all processed, and the rest are
+// processed by the next operation.
+func PayDueStandingOrders(height base.Height, getStateFunc base.GetStateFunc) ([]base.StateMergeValue, error) {
+	cursor, found, err := standingOrderCursor(getStateFunc)
+	switch {
+	case err != nil:
+		return nil, err
+	case !found, cursor >= height:
+		return nil, nil
+	}
+
+	to := height
+	if cursor+types.MaxStandingOrderScanHeights < to {
+		to = cursor + types.MaxStandingOrderScanHeights
+	}
+
+	updated := map[string]base.State{}
+	getUpdatedStateFunc := func(key string) (base.State, bool, error) {
+		if st, found := updated[key]; found {
+			return st, true, nil
+		}
+
+		return getStateFunc(key)
+	}
+
+	adds := map[base.Height][]string{}
+	removes := map[base.Height][]string{}
+	last := cursor
+
+	var processed int
+
+end:
+	for h := cursor + 1; h <= to; h++ {
+		keys, err := standingOrderDues(h, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range keys {
+			if processed >= types.MaxStandingOrderPaymentsPerHeight {
+				break end
+			}
+
+			processed++
+
+			stmvs, next, ok, err := payStandingOrder(height, keys[i], getUpdatedStateFunc)
+			if err != nil {
+				return nil, err
+			}
+
+			for j := range stmvs {
+				k := stmvs[j].Key()
+				updated[k] = common.NewBaseState(height, k, stmvs[j].Value(), nil, nil)
+			}
+
+			removes[h] = append(removes[h], keys[i])
+
+			if ok {
+				adds[next] = append(adds[next], keys[i])
+			}
+		}
+
+		last = h
+	}
+
+	keys := make([]string, 0, len(updated))
+	for k := range updated {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	stmvs := make([]base.StateMergeValue, 0, len(keys)+len(adds)+len(removes)+1)
+
+	for i := range keys {
+		v := updated[keys[i]].Value()
+
+		if sv, ok := v.(currency.StandingOrderStateValue); ok {
+			stmvs = append(stmvs, currency.NewStandingOrderMergeValue(keys[i], sv))
+
+			continue
+		}
+
+		stmvs = append(stmvs, state.NewStateMergeValue(keys[i], v))
+	}
+
+	heights := make([]base.Height, 0, len(adds)+len(removes))
+	for h := range removes {
+		heights = append(heights, h)
+	}
+
+	for h := range adds {
+		if _, found := removes[h]; !found {
+			heights = append(heights, h)
+		}
+	}
+
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] < heights[j]
+	})
+
+	for i := range heights {
+		stmvs = append(stmvs, currency.NewStandingOrderDueMergeValue(heights[i], adds[heights[i]], removes[heights[i]]))
+	}
+
+	if last > cursor {
+		stmvs = append(stmvs, currency.NewStandingOrderCursorMergeValue(last))
+	}
+
+	return stmvs, nil
+}
+
+func standingOrderCursor(getStateFunc base.GetStateFunc) (base.Height, bool, error) {
+	switch st, found, err := getStateFunc(currency.StateKeyStandingOrderCursor); {
+	case err != nil:
+		return base.NilHeight, false, err
+	case !found:
+		return base.NilHeight, false, nil
+	default:
+		sv, err := currency.StateStandingOrderCursorValue(st)
+		if err != nil {
+			return base.NilHeight, false, err
+		}
+
+		return sv.Height, true, nil
+	}
+}
+
+func standingOrderDues(height base.Height, getStateFunc base.GetStateFunc) ([]string, error) {
+	switch st, found, err := getStateFunc(currency.StateKeyStandingOrderDue(height)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		sv, err := currency.StateStandingOrderDueValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		return sv.Keys, nil
+	}
+}
+
+// payStandingOrder pays the due payments of the standing order of key. It
+// returns the height of the next payment, which is over height; the bool is
+// false when the order is finished or not found.
+func payStandingOrder(
+	height base.Height, key string, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.Height, bool, error) {
+	var sv currency.StandingOrderStateValue
+
+	switch st, found, err := getStateFunc(key); {
+	case err != nil:
+		return nil, base.NilHeight, false, err
+	case !found:
+		return nil, base.NilHeight, false, nil
+	default:
+		if sv, err = currency.StateStandingOrderValue(st); err != nil {
+			return nil, base.NilHeight, false, err
+		}
+	}
+
+	n := sv.Due(height)
+	if n < 1 {
+		// NOTE the order is moved to the next due height.
+		next, ok := sv.NextDue()
+
+		return nil, next, ok, nil
+	}
+
+	stmvs, err := payStandingOrderAmount(sv, n, getStateFunc)
+
+	var reason base.OperationProcessReasonError
+
+	switch {
+	case err == nil:
+		sv = sv.Pay(n)
+		stmvs = append(stmvs, currency.NewStandingOrderMergeValue(key, sv))
+	case errors.As(err, &reason):
+		// NOTE the order, which sender can not pay, like the insufficient or
+		// frozen balance, is cancelled.
+		sv = sv.Cancel()
+		stmvs = []base.StateMergeValue{currency.NewStandingOrderMergeValue(key, sv)}
+	default:
+		// NOTE the order is tried again at the next height.
+		return nil, height + 1, true, nil
+	}
+
+	next, ok := sv.NextDue()
+
+	return stmvs, next, ok, nil
+}
+
+func payStandingOrderAmount(
+	sv currency.StandingOrderStateValue, n uint64, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	items := make([]AmountsItem, n)
+	for i := range items {
+		items[i] = amountsItem{sv.Amount}
+	}
+
+	feeReceiveBalSts, required, err := CalculateItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to calculate fee")
+	}
+
+	senderBalSts, err := CheckEnoughBalance(sv.Sender, required, getStateFunc)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to check enough balance")
+	}
+
+	stmvs, err := PayRequired(senderBalSts, nil, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to pay required")
+	}
+
+	cid := sv.Amount.Currency()
+
+	return creditBalance(
+		stmvs, currency.StateKeyBalance(sv.Receiver, cid), cid, sv.Amount.Big().MulInt64(int64(n)), getStateFunc)
+}
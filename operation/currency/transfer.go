@@ -2,6 +2,7 @@ package currency
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -21,13 +22,16 @@ type TransferItem interface {
 	AmountsItem
 	Bytes() []byte
 	Receiver() base.Address
+	Memo() string
 	Rebuild() TransferItem
 }
 
 type TransferFact struct {
 	base.BaseFact
-	sender base.Address
-	items  []TransferItem
+	sender      base.Address
+	items       []TransferItem
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
 }
 
 func NewTransferFact(
@@ -60,10 +64,22 @@ func (fact TransferFact) Bytes() []byte {
 		its[i] = fact.items[i].Bytes()
 	}
 
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(its...),
+		fc,
+		fp,
 	)
 }
 
@@ -86,6 +102,12 @@ func (fact TransferFact) IsValid(b []byte) error {
 		return err
 	}
 
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	foundReceivers := map[string]struct{}{}
 	for i := range fact.items {
 		it := fact.items[i]
@@ -104,6 +126,16 @@ func (fact TransferFact) IsValid(b []byte) error {
 		}
 	}
 
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
 	return nil
 }
 
@@ -119,6 +151,19 @@ func (fact TransferFact) Items() []TransferItem {
 	return fact.items
 }
 
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of each amount.
+func (fact TransferFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact TransferFact) SetFeeCurrency(cid types.CurrencyID) TransferFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
 func (fact TransferFact) Rebuild() TransferFact {
 	items := make([]TransferItem, len(fact.items))
 	for i := range fact.items {
@@ -140,9 +185,24 @@ func (fact TransferFact) Addresses() ([]base.Address, error) {
 
 	as[len(fact.items)] = fact.Sender()
 
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
+func (fact TransferFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact TransferFact) SetFeePayer(feePayer base.Address) TransferFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
 type Transfer struct {
 	common.BaseOperation
 }
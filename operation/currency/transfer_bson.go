@@ -13,19 +13,23 @@ import (
 func (fact TransferFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":  fact.Hint().String(),
-			"sender": fact.sender,
-			"items":  fact.items,
-			"hash":   fact.BaseFact.Hash().String(),
-			"token":  fact.BaseFact.Token(),
+			"_hint":        fact.Hint().String(),
+			"sender":       fact.sender,
+			"items":        fact.items,
+			"fee_currency": fact.feeCurrency,
+			"fee_payer":    fact.feePayer,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
 		},
 	)
 }
 
 type TransferFactBSONUnmarshaler struct {
-	Hint   string   `bson:"_hint"`
-	Sender string   `bson:"sender"`
-	Items  bson.Raw `bson:"items"`
+	Hint        string   `bson:"_hint"`
+	Sender      string   `bson:"sender"`
+	Items       bson.Raw `bson:"items"`
+	FeeCurrency string   `bson:"fee_currency"`
+	FeePayer    string   `bson:"fee_payer"`
 }
 
 func (fact *TransferFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -52,7 +56,7 @@ func (fact *TransferFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	return fact.unpack(enc, uf.Sender, uf.Items)
+	return fact.unpack(enc, uf.Sender, uf.Items, uf.FeeCurrency, uf.FeePayer)
 }
 
 func (op Transfer) MarshalBSON() ([]byte, error) {
@@ -0,0 +1,195 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	TransferFromFactHint = hint.MustNewHint("mitum-currency-transfer-from-operation-fact-v0.0.1")
+	TransferFromHint     = hint.MustNewHint("mitum-currency-transfer-from-operation-v0.0.1")
+)
+
+// TransferFromFact transfers amount from the balance of owner to receiver
+// within the allowance, which owner approved to sender. sender signs and pays
+// the fee, unless fee payer is set, and the allowance is reduced by amount.
+type TransferFromFact struct {
+	base.BaseFact
+	sender      base.Address
+	owner       base.Address
+	receiver    base.Address
+	amount      types.Amount
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewTransferFromFact(
+	token []byte, sender, owner, receiver base.Address, amount types.Amount,
+) TransferFromFact {
+	bf := base.NewBaseFact(TransferFromFactHint, token)
+	fact := TransferFromFact{
+		BaseFact: bf,
+		sender:   sender,
+		owner:    owner,
+		receiver: receiver,
+		amount:   amount,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact TransferFromFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact TransferFromFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferFromFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.owner.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact TransferFromFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.owner, fact.receiver, fact.amount); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.owner) {
+		return util.ErrInvalid.Errorf("owner is same with sender, %v", fact.sender)
+	}
+
+	if fact.receiver.Equal(fact.owner) {
+		return util.ErrInvalid.Errorf("receiver is same with owner, %v", fact.owner)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("amount should be over zero")
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		switch {
+		case fact.feePayer.Equal(fact.sender):
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		case fact.feePayer.Equal(fact.owner):
+			return util.ErrInvalid.Errorf("fee payer is same with owner, %v", fact.owner)
+		}
+	}
+
+	return nil
+}
+
+func (fact TransferFromFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact TransferFromFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferFromFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact TransferFromFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact TransferFromFact) Amount() types.Amount {
+	return fact.amount
+}
+
+// Amounts implements AmountsItem for the fee calculation.
+func (fact TransferFromFact) Amounts() []types.Amount {
+	return []types.Amount{fact.amount}
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact TransferFromFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact TransferFromFact) SetFeeCurrency(cid types.CurrencyID) TransferFromFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact TransferFromFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact TransferFromFact) SetFeePayer(feePayer base.Address) TransferFromFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact TransferFromFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.owner, fact.receiver}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type TransferFrom struct {
+	common.BaseOperation
+}
+
+func NewTransferFrom(fact TransferFromFact) (TransferFrom, error) {
+	return TransferFrom{BaseOperation: common.NewBaseOperation(TransferFromHint, fact)}, nil
+}
+
+func (op *TransferFrom) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
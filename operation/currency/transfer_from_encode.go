@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *TransferFromFact) unpack(enc encoder.Encoder, sd, ow, rc string, bam []byte, fc, fp string) error {
+	e := util.StringError("failed to unmarshal TransferFromFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ow, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.owner = a
+	}
+
+	switch a, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.receiver = a
+	}
+
+	if hinter, err := enc.Decode(bam); err != nil {
+		return e.Wrap(err)
+	} else if am, ok := hinter.(types.Amount); !ok {
+		return e.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		fact.amount = am
+	}
+
+	fact.feeCurrency = types.CurrencyID(fc)
+
+	if len(fp) > 0 {
+		switch a, err := base.DecodeAddress(fp, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			fact.feePayer = a
+		}
+	}
+
+	return nil
+}
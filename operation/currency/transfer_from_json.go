@@ -0,0 +1,79 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type TransferFromFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	Owner       base.Address     `json:"owner"`
+	Receiver    base.Address     `json:"receiver"`
+	Amount      types.Amount     `json:"amount"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact TransferFromFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TransferFromFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Owner:                 fact.owner,
+		Receiver:              fact.receiver,
+		Amount:                fact.amount,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type TransferFromFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Owner       string          `json:"owner"`
+	Receiver    string          `json:"receiver"`
+	Amount      json.RawMessage `json:"amount"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
+}
+
+func (fact *TransferFromFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of TransferFromFact")
+
+	var uf TransferFromFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Owner, uf.Receiver, uf.Amount, uf.FeeCurrency, uf.FeePayer)
+}
+
+type transferFromMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op TransferFrom) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(transferFromMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *TransferFrom) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode TransferFrom")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
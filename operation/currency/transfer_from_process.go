@@ -0,0 +1,169 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var transferFromProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferFromProcessor)
+	},
+}
+
+func (TransferFrom) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type TransferFromProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewTransferFromProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new TransferFromProcessor")
+
+		nopp := transferFromProcessorPool.Get()
+		opp, ok := nopp.(*TransferFromProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected TransferFromProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *TransferFromProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(TransferFromFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected TransferFromFact, not %T", op.Fact()), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.owner), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of owner %v; %w", fact.owner, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyAccount(fact.receiver), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of receiver %v; %w", fact.receiver, err), nil
+	}
+
+	if err := state.CheckExistsState(currency.StateKeyCurrencyDesign(fact.amount.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", fact.amount.Currency(), err), nil
+	}
+
+	if err := state.CheckExistsState(
+		currency.StateKeyAllowance(fact.owner, fact.sender, fact.amount.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"allowance not found, %v, %v, %v; %w", fact.owner, fact.sender, fact.amount.Currency(), err), nil
+	}
+
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *TransferFromProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(TransferFromFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected TransferFromFact, not %T", op.Fact()), nil
+	}
+
+	cid := fact.amount.Currency()
+
+	ast, err := state.ExistsState(currency.StateKeyAllowance(fact.owner, fact.sender, cid), "allowance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"allowance not found, %v, %v, %v; %w", fact.owner, fact.sender, cid, err), nil
+	}
+
+	av, err := currency.StateAllowanceValue(ast)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get allowance value; %w", err), nil
+	}
+
+	if av.Amount.Big().Compare(fact.amount.Big()) < 0 {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"insufficient allowance, %v, %v; %v < %v", fact.owner, fact.sender, av.Amount.Big(), fact.amount.Big()), nil
+	}
+
+	feeReceiveBalSts, required, err := CalculateItemsFeeWithFeeCurrency(getStateFunc, []AmountsItem{fact}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	// NOTE sender pays the fee like fee payer, unless fee payer is set; owner
+	// pays only the amount.
+	payer := fact.sender
+	if fact.feePayer != nil {
+		payer = fact.feePayer
+	}
+
+	ownerBalSts, payerBalSts, err := CheckEnoughBalanceWithFeePayer(fact.owner, payer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	stmvs, err := PayRequired(ownerBalSts, payerBalSts, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+
+	// NOTE receiver can be sender or fee receiver, whose balance is already
+	// updated.
+	rk := currency.StateKeyBalance(fact.receiver, cid)
+	if stmvs, err = creditBalance(stmvs, rk, cid, fact.amount.Big(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to update receiver balance, %v; %w", rk, err), nil
+	}
+
+	stmvs = append(stmvs, state.NewStateMergeValue(ast.Key(), currency.NewAllowanceStateValue(
+		av.Owner, av.Spender, av.Amount.WithBig(av.Amount.Big().Sub(fact.amount.Big())),
+	)))
+
+	return stmvs, nil, nil
+}
+
+func (opp *TransferFromProcessor) Close() error {
+	transferFromProcessorPool.Put(opp)
+
+	return nil
+}
@@ -11,6 +11,7 @@ type BaseTransferItem struct {
 	hint.BaseHinter
 	receiver base.Address
 	amounts  []types.Amount
+	memo     string
 }
 
 func NewBaseTransferItem(ht hint.Hint, receiver base.Address, amounts []types.Amount) BaseTransferItem {
@@ -29,6 +30,10 @@ func (it BaseTransferItem) Bytes() []byte {
 		bs[i+1] = it.amounts[i].Bytes()
 	}
 
+	if len(it.memo) > 0 {
+		bs = append(bs, []byte(it.memo))
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -37,6 +42,10 @@ func (it BaseTransferItem) IsValid([]byte) error {
 		return err
 	}
 
+	if err := types.IsValidMemo(it.memo); err != nil {
+		return err
+	}
+
 	if n := len(it.amounts); n == 0 {
 		return util.ErrInvalid.Errorf("empty amounts")
 	}
@@ -67,6 +76,10 @@ func (it BaseTransferItem) Amounts() []types.Amount {
 	return it.amounts
 }
 
+func (it BaseTransferItem) Memo() string {
+	return it.memo
+}
+
 func (it BaseTransferItem) Rebuild() TransferItem {
 	ams := make([]types.Amount, len(it.amounts))
 	for i := range it.amounts {
@@ -13,6 +13,7 @@ func (it BaseTransferItem) MarshalBSON() ([]byte, error) {
 			"_hint":    it.Hint().String(),
 			"receiver": it.receiver,
 			"amounts":  it.amounts,
+			"memo":     it.memo,
 		},
 	)
 }
@@ -21,6 +22,7 @@ type TransferItemBSONUnmarshaler struct {
 	Hint     string   `bson:"_hint"`
 	Receiver string   `bson:"receiver"`
 	Amounts  bson.Raw `bson:"amounts"`
+	Memo     string   `bson:"memo"`
 }
 
 func (it *BaseTransferItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -36,5 +38,5 @@ func (it *BaseTransferItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, ht, uit.Receiver, uit.Amounts)
+	return it.unpack(enc, ht, uit.Receiver, uit.Amounts, uit.Memo)
 }
@@ -9,7 +9,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (it *BaseTransferItem) unpack(enc encoder.Encoder, ht hint.Hint, rc string, bam []byte) error {
+func (it *BaseTransferItem) unpack(enc encoder.Encoder, ht hint.Hint, rc string, bam []byte, memo string) error {
 	e := util.StringError("failed to unmarshal BaseTransferItem")
 
 	it.BaseHinter = hint.NewBaseHinter(ht)
@@ -37,6 +37,7 @@ func (it *BaseTransferItem) unpack(enc encoder.Encoder, ht hint.Hint, rc string,
 	}
 
 	it.amounts = amounts
+	it.memo = memo
 
 	return nil
 }
@@ -14,6 +14,7 @@ type TransferItemJSONPacker struct {
 	hint.BaseHinter
 	Receiver base.Address   `json:"receiver"`
 	Amounts  []types.Amount `json:"amounts"`
+	Memo     string         `json:"memo,omitempty"`
 }
 
 func (it BaseTransferItem) MarshalJSON() ([]byte, error) {
@@ -21,6 +22,7 @@ func (it BaseTransferItem) MarshalJSON() ([]byte, error) {
 		BaseHinter: it.BaseHinter,
 		Receiver:   it.receiver,
 		Amounts:    it.amounts,
+		Memo:       it.memo,
 	})
 }
 
@@ -28,6 +30,7 @@ type BaseTransferItemJSONUnpacker struct {
 	Hint     hint.Hint       `json:"_hint"`
 	Receiver string          `json:"receiver"`
 	Amounts  json.RawMessage `json:"amounts"`
+	Memo     string          `json:"memo"`
 }
 
 func (it *BaseTransferItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -38,5 +41,5 @@ func (it *BaseTransferItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, uit.Hint, uit.Receiver, uit.Amounts)
+	return it.unpack(enc, uit.Hint, uit.Receiver, uit.Amounts, uit.Memo)
 }
@@ -40,3 +40,9 @@ func (it TransferItemMultiAmounts) Rebuild() TransferItem {
 
 	return it
 }
+
+func (it TransferItemMultiAmounts) SetMemo(memo string) TransferItemMultiAmounts {
+	it.memo = memo
+
+	return it
+}
@@ -53,11 +53,16 @@ func (opp *TransferItemProcessor) PreProcess(
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
 
-		_, err := state.ExistsCurrencyPolicy(am.Currency(), getStateFunc)
+		policy, err := state.ExistsCurrencyPolicy(am.Currency(), getStateFunc)
 		if err != nil {
 			return err
 		}
 
+		if n := uint(len(opp.item.Memo())); n > policy.MaxMemoSize() {
+			return base.NewBaseOperationProcessReasonError(
+				"memo over max size of currency, %v, %d > %d", am.Currency(), n, policy.MaxMemoSize())
+		}
+
 		st, _, err := getStateFunc(currency.StateKeyBalance(opp.item.Receiver(), am.Currency()))
 		if err != nil {
 			return err
@@ -161,7 +166,13 @@ func (opp *TransferProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot transfer amounts, %v; %w", fact.Sender(), err), nil
 	}
 
-	if err := state.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing :  %w", err), nil
 	}
 
@@ -194,14 +205,14 @@ func (opp *TransferProcessor) Process( // nolint:dupl
 	}
 
 	var (
-		senderBalSts, feeReceiveBalSts map[types.CurrencyID]base.State
-		required                       map[types.CurrencyID][2]common.Big
-		err                            error
+		senderBalSts, feePayerBalSts, feeReceiveBalSts map[types.CurrencyID]base.State
+		required                                       map[types.CurrencyID][2]common.Big
+		err                                            error
 	)
 
 	if feeReceiveBalSts, required, err = opp.calculateItemsFee(op, getStateFunc); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
-	} else if senderBalSts, err = CheckEnoughBalance(fact.sender, required, getStateFunc); err != nil {
+	} else if senderBalSts, feePayerBalSts, err = CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, getStateFunc); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
 	} else {
 		opp.required = required
@@ -235,39 +246,12 @@ func (opp *TransferProcessor) Process( // nolint:dupl
 		stmvs = append(stmvs, s...)
 	}
 
-	for cid := range senderBalSts {
-		v, ok := senderBalSts[cid].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSts[cid].Value()), nil
-		}
-
-		var stmv base.StateMergeValue
-		if senderBalSts[cid].Key() == feeReceiveBalSts[cid].Key() {
-			stmv = state.NewStateMergeValue(
-				senderBalSts[cid].Key(),
-				currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[cid][0]).Add(opp.required[cid][1]))),
-			)
-		} else {
-			stmv = state.NewStateMergeValue(
-				senderBalSts[cid].Key(),
-				currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[cid][0]))),
-			)
-			r, ok := feeReceiveBalSts[cid].Value().(currency.BalanceStateValue)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", feeReceiveBalSts[cid].Value()), nil
-			}
-			stmvs = append(
-				stmvs,
-				state.NewStateMergeValue(
-					feeReceiveBalSts[cid].Key(),
-					currency.NewBalanceStateValue(r.Amount.WithBig(r.Amount.Big().Add(opp.required[cid][1]))),
-				),
-			)
-		}
-		stmvs = append(stmvs, stmv)
+	sts, err := PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, opp.required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
 	}
 
-	return stmvs, nil, nil
+	return append(stmvs, sts...), nil, nil
 }
 
 func (opp *TransferProcessor) Close() error {
@@ -293,5 +277,10 @@ func (opp *TransferProcessor) calculateItemsFee(op base.Operation, getStateFunc
 		items[i] = fact.items[i]
 	}
 
-	return CalculateItemsFee(getStateFunc, items)
+	feeReceiveSts, required, err := CalculateItemsFee(getStateFunc, items)
+	if err != nil || len(fact.feeCurrency) < 1 {
+		return feeReceiveSts, required, err
+	}
+
+	return ConvertItemsFee(getStateFunc, fact.feeCurrency, feeReceiveSts, required)
 }
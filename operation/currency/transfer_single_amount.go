@@ -38,3 +38,9 @@ func (it TransferItemSingleAmount) Rebuild() TransferItem {
 
 	return it
 }
+
+func (it TransferItemSingleAmount) SetMemo(memo string) TransferItemSingleAmount {
+	it.memo = memo
+
+	return it
+}
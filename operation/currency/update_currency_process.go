@@ -106,8 +106,30 @@ func (opp *UpdateCurrencyProcessor) PreProcess(
 		}
 	}
 
-	if err := state.CheckExistsState(statecurrency.StateKeyCurrencyDesign(fact.currency), getStateFunc); err != nil {
-		return ctx, nil, base.NewBaseOperationProcessReasonError("currency not found, %v", fact.currency)
+	st, err := state.ExistsState(statecurrency.StateKeyCurrencyDesign(fact.currency), "currency design", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v", fact.currency), nil
+	}
+
+	de, err := statecurrency.StateCurrencyDesignValue(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to get currency design of %v; %w", fact.currency, err), nil
+	}
+
+	if ms := de.Policy().MaxSupply(); ms.OverZero() {
+		switch nms := fact.policy.MaxSupply(); {
+		case !nms.OverZero():
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"max supply of currency can not be removed, %v, %v", fact.currency, ms), nil
+		case nms.Compare(ms) > 0:
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"max supply of currency can not be raised, %v, %v > %v", fact.currency, nms, ms), nil
+		}
+	}
+
+	if fact.policy.IsOverMaxSupply(de.Aggregate()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"max supply under aggregate of currency, %v, %v < %v", fact.currency, fact.policy.MaxSupply(), de.Aggregate()), nil
 	}
 
 	return ctx, nil, nil
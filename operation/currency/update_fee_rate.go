@@ -0,0 +1,102 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateFeeRateFactHint = hint.MustNewHint("mitum-currency-update-fee-rate-operation-fact-v0.0.1")
+	UpdateFeeRateHint     = hint.MustNewHint("mitum-currency-update-fee-rate-operation-v0.0.1")
+)
+
+// UpdateFeeRateFact sets the rate to convert the fee of currency into
+// feeCurrency. With zero rate, feeCurrency is not accepted for currency.
+type UpdateFeeRateFact struct {
+	base.BaseFact
+	currency    types.CurrencyID
+	feeCurrency types.CurrencyID
+	rate        types.FeeRate
+}
+
+func NewUpdateFeeRateFact(
+	token []byte, currency, feeCurrency types.CurrencyID, rate types.FeeRate,
+) UpdateFeeRateFact {
+	fact := UpdateFeeRateFact{
+		BaseFact:    base.NewBaseFact(UpdateFeeRateFactHint, token),
+		currency:    currency,
+		feeCurrency: feeCurrency,
+		rate:        rate,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateFeeRateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateFeeRateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.currency.Bytes(),
+		fact.feeCurrency.Bytes(),
+		fact.rate.Bytes(),
+	)
+}
+
+func (fact UpdateFeeRateFact) IsValid(b []byte) error {
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.currency, fact.feeCurrency); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %v", err)
+	}
+
+	if fact.currency == fact.feeCurrency {
+		return util.ErrInvalid.Errorf("fee currency is same with currency, %v", fact.currency)
+	}
+
+	if err := fact.rate.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %v", err)
+	}
+
+	return nil
+}
+
+func (fact UpdateFeeRateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateFeeRateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateFeeRateFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateFeeRateFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact UpdateFeeRateFact) Rate() types.FeeRate {
+	return fact.rate
+}
+
+type UpdateFeeRate struct {
+	common.BaseNodeOperation
+}
+
+func NewUpdateFeeRate(fact UpdateFeeRateFact) (UpdateFeeRate, error) {
+	return UpdateFeeRate{
+		BaseNodeOperation: common.NewBaseNodeOperation(UpdateFeeRateHint, fact),
+	}, nil
+}
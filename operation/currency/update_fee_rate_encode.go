@@ -0,0 +1,19 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateFeeRateFact) unpack(_ encoder.Encoder, cid, fcid string, rate string) error {
+	r, err := types.ParseFeeRate(rate)
+	if err != nil {
+		return err
+	}
+
+	fact.currency = types.CurrencyID(cid)
+	fact.feeCurrency = types.CurrencyID(fcid)
+	fact.rate = r
+
+	return nil
+}
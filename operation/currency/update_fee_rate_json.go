@@ -0,0 +1,68 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateFeeRateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Currency    types.CurrencyID `json:"currency"`
+	FeeCurrency types.CurrencyID `json:"fee_currency"`
+	Rate        types.FeeRate    `json:"rate"`
+}
+
+func (fact UpdateFeeRateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateFeeRateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Currency:              fact.currency,
+		FeeCurrency:           fact.feeCurrency,
+		Rate:                  fact.rate,
+	})
+}
+
+type UpdateFeeRateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Currency    string `json:"currency"`
+	FeeCurrency string `json:"fee_currency"`
+	Rate        string `json:"rate"`
+}
+
+func (fact *UpdateFeeRateFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateFeeRateFact")
+
+	var uf UpdateFeeRateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Currency, uf.FeeCurrency, uf.Rate)
+}
+
+type updateFeeRateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateFeeRate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(updateFeeRateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateFeeRate) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode UpdateFeeRate")
+
+	var ubo common.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
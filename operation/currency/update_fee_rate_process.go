@@ -0,0 +1,135 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var updateFeeRateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateFeeRateProcessor)
+	},
+}
+
+func (UpdateFeeRate) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type UpdateFeeRateProcessor struct {
+	*base.BaseOperationProcessor
+	suffrage  base.Suffrage
+	threshold base.Threshold
+}
+
+func NewUpdateFeeRateProcessor(threshold base.Threshold) types.GetNewProcessor {
+	return func(height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateFeeRateProcessor")
+
+		nopp := updateFeeRateProcessorPool.Get()
+		opp, ok := nopp.(*UpdateFeeRateProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected UpdateFeeRateProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.threshold = threshold
+
+		switch i, found, err := getStateFunc(isaac.SuffrageStateKey); {
+		case err != nil:
+			return nil, e.Wrap(err)
+		case !found, i == nil:
+			return nil, e.Wrap(isaac.ErrStopProcessingRetry.Errorf("empty state"))
+		default:
+			sufstv := i.Value().(base.SuffrageNodesStateValue) //nolint:forcetypeassert //...
+
+			suf, err := sufstv.Suffrage()
+			if err != nil {
+				return nil, e.Wrap(isaac.ErrStopProcessingRetry.Errorf("failed to get suffrage from state"))
+			}
+
+			opp.suffrage = suf
+		}
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateFeeRateProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess for UpdateFeeRate")
+
+	nop, ok := op.(UpdateFeeRate)
+	if !ok {
+		return ctx, nil, e.Errorf("not UpdateFeeRate, %T", op)
+	}
+
+	if err := base.CheckFactSignsBySuffrage(opp.suffrage, opp.threshold, nop.NodeSigns()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("not enough signs"), nil
+	}
+
+	fact, ok := op.Fact().(UpdateFeeRateFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not UpdateFeeRateFact, %T", op.Fact())
+	}
+
+	if err := state.CheckExistsState(statecurrency.StateKeyCurrencyDesign(fact.currency), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v", fact.currency), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.StateKeyCurrencyDesign(fact.feeCurrency), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("fee currency not found, %v", fact.feeCurrency), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateFeeRateProcessor) Process(
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(UpdateFeeRateFact)
+	if !ok {
+		return nil, nil, errors.Errorf("not UpdateFeeRateFact, %T", op.Fact())
+	}
+
+	sts := []base.StateMergeValue{
+		state.NewStateMergeValue(
+			statecurrency.StateKeyFeeRate(fact.currency, fact.feeCurrency),
+			statecurrency.NewFeeRateStateValue(fact.currency, fact.feeCurrency, fact.rate),
+		),
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *UpdateFeeRateProcessor) Close() error {
+	opp.suffrage = nil
+	opp.threshold = 0
+
+	updateFeeRateProcessorPool.Put(opp)
+
+	return nil
+}
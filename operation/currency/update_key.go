@@ -16,9 +16,11 @@ var (
 
 type UpdateKeyFact struct {
 	base.BaseFact
-	target   base.Address
-	keys     types.AccountKeys
-	currency types.CurrencyID
+	target      base.Address
+	keys        types.AccountKeys
+	currency    types.CurrencyID
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
 }
 
 func NewUpdateKeyFact(
@@ -48,11 +50,23 @@ func (fact UpdateKeyFact) GenerateHash() util.Hash {
 }
 
 func (fact UpdateKeyFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.target.Bytes(),
 		fact.keys.Bytes(),
 		fact.currency.Bytes(),
+		fc,
+		fp,
 	)
 }
 
@@ -69,6 +83,22 @@ func (fact UpdateKeyFact) IsValid(b []byte) error {
 		return err
 	}
 
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.target) {
+			return util.ErrInvalid.Errorf("fee payer is same with target, %v", fact.target)
+		}
+	}
+
 	return nil
 }
 
@@ -83,9 +113,37 @@ func (fact UpdateKeyFact) Target() base.Address {
 func (fact UpdateKeyFact) Addresses() ([]base.Address, error) {
 	as := make([]base.Address, 1)
 	as[0] = fact.Target()
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact UpdateKeyFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact UpdateKeyFact) SetFeeCurrency(cid types.CurrencyID) UpdateKeyFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateKeyFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact UpdateKeyFact) SetFeePayer(feePayer base.Address) UpdateKeyFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
 func (fact UpdateKeyFact) Keys() types.AccountKeys {
 	return fact.keys
 }
@@ -13,21 +13,25 @@ import (
 func (fact UpdateKeyFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":    fact.Hint().String(),
-			"target":   fact.target,
-			"keys":     fact.keys,
-			"currency": fact.currency,
-			"hash":     fact.BaseFact.Hash().String(),
-			"token":    fact.BaseFact.Token(),
+			"_hint":        fact.Hint().String(),
+			"target":       fact.target,
+			"keys":         fact.keys,
+			"currency":     fact.currency,
+			"fee_currency": fact.feeCurrency,
+			"fee_payer":    fact.feePayer,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
 		},
 	)
 }
 
 type UpdateKeyFactBSONUnmarshaler struct {
-	Hint     string   `bson:"_hint"`
-	Target   string   `bson:"target"`
-	Keys     bson.Raw `bson:"keys"`
-	Currency string   `bson:"currency"`
+	Hint        string   `bson:"_hint"`
+	Target      string   `bson:"target"`
+	Keys        bson.Raw `bson:"keys"`
+	Currency    string   `bson:"currency"`
+	FeeCurrency string   `bson:"fee_currency"`
+	FeePayer    string   `bson:"fee_payer"`
 }
 
 func (fact *UpdateKeyFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -54,7 +58,7 @@ func (fact *UpdateKeyFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	return fact.unpack(enc, uf.Target, uf.Keys, uf.Currency)
+	return fact.unpack(enc, uf.Target, uf.Keys, uf.Currency, uf.FeeCurrency, uf.FeePayer)
 }
 
 func (op UpdateKey) MarshalBSON() ([]byte, error) {
@@ -8,7 +8,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (fact *UpdateKeyFact) unpack(enc encoder.Encoder, tg string, bks []byte, cid string) error {
+func (fact *UpdateKeyFact) unpack(enc encoder.Encoder, tg string, bks []byte, cid, fc, fp string) error {
 	e := util.StringError("failed to unmarshal UpdateKeyFact")
 
 	switch ad, err := base.DecodeAddress(tg, enc); {
@@ -28,5 +28,16 @@ func (fact *UpdateKeyFact) unpack(enc encoder.Encoder, tg string, bks []byte, ci
 
 	fact.currency = types.CurrencyID(cid)
 
+	fact.feeCurrency = types.CurrencyID(fc)
+
+	if len(fp) > 0 {
+		switch a, err := base.DecodeAddress(fp, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			fact.feePayer = a
+		}
+	}
+
 	return nil
 }
@@ -12,9 +12,11 @@ import (
 
 type UpdateKeyFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Target   base.Address      `json:"target"`
-	Keys     types.AccountKeys `json:"keys"`
-	Currency types.CurrencyID  `json:"currency"`
+	Target      base.Address      `json:"target"`
+	Keys        types.AccountKeys `json:"keys"`
+	Currency    types.CurrencyID  `json:"currency"`
+	FeeCurrency types.CurrencyID  `json:"fee_currency,omitempty"`
+	FeePayer    base.Address      `json:"fee_payer,omitempty"`
 }
 
 func (fact UpdateKeyFact) MarshalJSON() ([]byte, error) {
@@ -23,14 +25,18 @@ func (fact UpdateKeyFact) MarshalJSON() ([]byte, error) {
 		Target:                fact.target,
 		Keys:                  fact.keys,
 		Currency:              fact.currency,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
 	})
 }
 
 type UpdateKeyFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Target   string          `json:"target"`
-	Keys     json.RawMessage `json:"keys"`
-	Currency string          `json:"currency"`
+	Target      string          `json:"target"`
+	Keys        json.RawMessage `json:"keys"`
+	Currency    string          `json:"currency"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
 }
 
 func (fact *UpdateKeyFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -43,7 +49,7 @@ func (fact *UpdateKeyFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
-	return fact.unpack(enc, uf.Target, uf.Keys, uf.Currency)
+	return fact.unpack(enc, uf.Target, uf.Keys, uf.Currency, uf.FeeCurrency, uf.FeePayer)
 }
 
 type updateKeyMarshaler struct {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"sync"
 
-	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum-currency/v3/state"
 	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
@@ -68,7 +67,13 @@ func (opp *UpdateKeyProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("expected UpdateKeyFact, not %T", op.Fact()), nil
 	}
 
-	if err := state.CheckFactSignsByState(fact.target, op.Signs(), getStateFunc); err != nil {
+	if fact.feePayer != nil {
+		if err := CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.target, fact.feePayer, op.Signs(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
 	}
 
@@ -102,51 +107,27 @@ func (opp *UpdateKeyProcessor) Process( // nolint:dupl
 		return nil, base.NewBaseOperationProcessReasonError("failed to check existence of target %v ; %w", fact.target, err), nil
 	}
 
-	var fee common.Big
-	var policy types.CurrencyPolicy
-	if policy, err = state.ExistsCurrencyPolicy(fact.currency, getStateFunc); err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check existence of currency %v; %w", fact.currency, err), nil
-	} else if fee, err = policy.Feeer().Fee(common.ZeroBig); err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency %v; %w", fact.currency, err), nil
+	payer := fact.target
+	if fact.feePayer != nil {
+		payer = fact.feePayer
 	}
 
-	var tgBalSt base.State
-	if tgBalSt, err = state.ExistsState(currency.StateKeyBalance(fact.target, fact.currency), "balance of target", getStateFunc); err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check existence of target balance %v ; %w", fact.target, err), nil
-	} else if b, err := currency.StateBalanceValue(tgBalSt); err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check existence of target balance %v, %v ; %w", fact.currency, fact.target, err), nil
-	} else if b.Big().Compare(fee) < 0 {
-		return nil, base.NewBaseOperationProcessReasonError("insufficient balance with fee %v ,%v", fact.currency, fact.target), nil
+	// NOTE the fee is charged like the transfer of zero amount of currency.
+	feeReceiveBalSts, required, err := CalculateItemsFeeWithFeeCurrency(
+		getStateFunc, []AmountsItem{amountsItem{types.NewZeroAmount(fact.currency)}}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
 	}
 
-	var stmvs []base.StateMergeValue // nolint:prealloc
-	v, ok := tgBalSt.Value().(currency.BalanceStateValue)
-	if !ok {
-		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", tgBalSt.Value()), nil
+	payerBalSts, err := CheckEnoughBalance(payer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee payer balance, %v; %w", payer, err), nil
 	}
 
-	tgAmount := v.Amount.WithBig(v.Amount.Big().Sub(fee))
-
-	// stv := NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[i][0]).Sub(opp.required[i][1])))
-	if policy.Feeer().Receiver() != nil {
-		if err := state.CheckExistsState(currency.StateKeyAccount(policy.Feeer().Receiver()), getStateFunc); err != nil {
-			return nil, nil, err
-		} else if feeRcvrSt, found, err := getStateFunc(currency.StateKeyBalance(policy.Feeer().Receiver(), fact.currency)); err != nil {
-			return nil, nil, err
-		} else if !found {
-			return nil, nil, errors.Errorf("feeer receiver %s not found", policy.Feeer().Receiver())
-		} else if feeRcvrSt.Key() == tgBalSt.Key() {
-			tgAmount = tgAmount.WithBig(tgAmount.Big().Add(fee))
-		} else {
-			r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
-			if !ok {
-				return nil, nil, errors.Errorf("invalid BalanceState value found, %T", feeRcvrSt.Value())
-			}
-			stmvs = append(stmvs, state.NewStateMergeValue(feeRcvrSt.Key(), currency.NewBalanceStateValue(r.Amount.WithBig(r.Amount.Big().Add(fee)))))
-		}
+	stmvs, err := PayRequired(payerBalSts, nil, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
 	}
-	stmv := currency.NewBalanceStateValue(tgAmount)
-	stmvs = append(stmvs, state.NewStateMergeValue(tgBalSt.Key(), stmv))
 
 	ac, err := currency.LoadStateAccountValue(tgAccSt)
 	if err != nil {
@@ -0,0 +1,167 @@
+package escrow
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ClaimEscrowFactHint = hint.MustNewHint("mitum-currency-claim-escrow-operation-fact-v0.0.1")
+	ClaimEscrowHint     = hint.MustNewHint("mitum-currency-claim-escrow-operation-v0.0.1")
+)
+
+// ClaimEscrowFact reveals the preimage of the hashlock and pays the escrowed
+// amount to the receiver of escrow, who should be the sender of the fact. The
+// whole amount is paid and the fee is charged like the transfer of zero amount
+// of the escrowed currency.
+type ClaimEscrowFact struct {
+	base.BaseFact
+	sender      base.Address
+	id          types.EscrowID
+	preimage    []byte
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewClaimEscrowFact(token []byte, sender base.Address, id types.EscrowID, preimage []byte) ClaimEscrowFact {
+	bf := base.NewBaseFact(ClaimEscrowFactHint, token)
+	fact := ClaimEscrowFact{
+		BaseFact: bf,
+		sender:   sender,
+		id:       id,
+		preimage: preimage,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimEscrowFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ClaimEscrowFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ClaimEscrowFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.id.Bytes(),
+		fact.preimage,
+		fc,
+		fp,
+	)
+}
+
+func (fact ClaimEscrowFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.id); err != nil {
+		return err
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return types.IsValidEscrowPreimage(fact.preimage)
+}
+
+func (fact ClaimEscrowFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ClaimEscrowFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ClaimEscrowFact) ID() types.EscrowID {
+	return fact.id
+}
+
+func (fact ClaimEscrowFact) Preimage() []byte {
+	return fact.preimage
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of escrow.
+func (fact ClaimEscrowFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact ClaimEscrowFact) SetFeeCurrency(cid types.CurrencyID) ClaimEscrowFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimEscrowFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact ClaimEscrowFact) SetFeePayer(feePayer base.Address) ClaimEscrowFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimEscrowFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type ClaimEscrow struct {
+	common.BaseOperation
+}
+
+func NewClaimEscrow(fact ClaimEscrowFact) (ClaimEscrow, error) {
+	return ClaimEscrow{BaseOperation: common.NewBaseOperation(ClaimEscrowHint, fact)}, nil
+}
+
+func (op *ClaimEscrow) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
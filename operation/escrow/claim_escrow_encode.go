@@ -0,0 +1,42 @@
+package escrow
+
+import (
+	"encoding/hex"
+
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ClaimEscrowFact) unpack(enc encoder.Encoder, sd, id, pi, fc, fp string) error {
+	e := util.StringError("failed to unmarshal ClaimEscrowFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	fact.id = types.EscrowID(id)
+
+	preimage, err := hex.DecodeString(pi)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.preimage = preimage
+
+	fact.feeCurrency = types.CurrencyID(fc)
+
+	if len(fp) > 0 {
+		switch a, err := base.DecodeAddress(fp, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			fact.feePayer = a
+		}
+	}
+
+	return nil
+}
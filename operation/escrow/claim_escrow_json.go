@@ -0,0 +1,76 @@
+package escrow
+
+import (
+	"encoding/hex"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ClaimEscrowFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	ID          types.EscrowID   `json:"id"`
+	Preimage    string           `json:"preimage"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact ClaimEscrowFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ClaimEscrowFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		ID:                    fact.id,
+		Preimage:              hex.EncodeToString(fact.preimage),
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type ClaimEscrowFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string `json:"sender"`
+	ID          string `json:"id"`
+	Preimage    string `json:"preimage"`
+	FeeCurrency string `json:"fee_currency"`
+	FeePayer    string `json:"fee_payer"`
+}
+
+func (fact *ClaimEscrowFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ClaimEscrowFact")
+
+	var uf ClaimEscrowFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.ID, uf.Preimage, uf.FeeCurrency, uf.FeePayer)
+}
+
+type claimEscrowMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ClaimEscrow) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(claimEscrowMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ClaimEscrow) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode ClaimEscrow")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package escrow
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/escrow"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var claimEscrowProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ClaimEscrowProcessor)
+	},
+}
+
+func (ClaimEscrow) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ClaimEscrowProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewClaimEscrowProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ClaimEscrowProcessor")
+
+		nopp := claimEscrowProcessorPool.Get()
+		opp, ok := nopp.(*ClaimEscrowProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected ClaimEscrowProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ClaimEscrowProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ClaimEscrowFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected ClaimEscrowFact, not %T", op.Fact()), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckExistsState(escrow.StateKeyEscrow(fact.id), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("escrow not found, %v; %w", fact.id, err), nil
+	}
+
+	if fact.feePayer != nil {
+		if err := currency.CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ClaimEscrowProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(ClaimEscrowFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected ClaimEscrowFact, not %T", op.Fact()), nil
+	}
+
+	est, err := state.ExistsState(escrow.StateKeyEscrow(fact.id), "escrow", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("escrow not found, %v; %w", fact.id, err), nil
+	}
+
+	v, err := escrow.StateEscrowValue(est)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get escrow value, %v; %w", fact.id, err), nil
+	}
+
+	switch {
+	case v.Status != escrow.EscrowStatusOpen:
+		return nil, base.NewBaseOperationProcessReasonError("escrow already %v, %v", v.Status, fact.id), nil
+	case !v.Receiver.Equal(fact.sender):
+		return nil, base.NewBaseOperationProcessReasonError(
+			"sender is not receiver of escrow, %v != %v", fact.sender, v.Receiver), nil
+	case opp.Height() > v.Timeout:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"escrow timed out, %v > %v", opp.Height(), v.Timeout), nil
+	case !bytes.Equal(types.EscrowHashlock(fact.preimage), v.Hashlock):
+		return nil, base.NewBaseOperationProcessReasonError("preimage does not match with hashlock, %v", fact.id), nil
+	}
+
+	payer := fact.sender
+	if fact.feePayer != nil {
+		payer = fact.feePayer
+	}
+
+	stmvs, err := payEscrowFee(payer, v.Amount.Currency(), fact.feeCurrency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee; %w", err), nil
+	}
+
+	stmvs, err = releaseEscrow(stmvs, v.Receiver, v.Amount, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to release escrow, %v; %w", fact.id, err), nil
+	}
+
+	stmvs = append(stmvs, state.NewStateMergeValue(est.Key(), escrow.NewEscrowStateValue(
+		v.Sender, v.Receiver, v.Amount, v.Hashlock, v.Timeout, escrow.EscrowStatusClaimed, fact.preimage,
+	)))
+
+	return stmvs, nil, nil
+}
+
+func (opp *ClaimEscrowProcessor) Close() error {
+	claimEscrowProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package escrow
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CreateEscrowFactHint = hint.MustNewHint("mitum-currency-create-escrow-operation-fact-v0.0.1")
+	CreateEscrowHint     = hint.MustNewHint("mitum-currency-create-escrow-operation-v0.0.1")
+)
+
+// CreateEscrowFact debits amount from sender and locks it under id. receiver
+// can claim it with the preimage of hashlock until timeout height, and after
+// timeout sender can refund it.
+type CreateEscrowFact struct {
+	base.BaseFact
+	sender      base.Address
+	receiver    base.Address
+	id          types.EscrowID
+	amount      types.Amount
+	hashlock    []byte
+	timeout     base.Height
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewCreateEscrowFact(
+	token []byte,
+	sender, receiver base.Address,
+	id types.EscrowID,
+	amount types.Amount,
+	hashlock []byte,
+	timeout base.Height,
+) CreateEscrowFact {
+	bf := base.NewBaseFact(CreateEscrowFactHint, token)
+	fact := CreateEscrowFact{
+		BaseFact: bf,
+		sender:   sender,
+		receiver: receiver,
+		id:       id,
+		amount:   amount,
+		hashlock: hashlock,
+		timeout:  timeout,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateEscrowFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CreateEscrowFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CreateEscrowFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.id.Bytes(),
+		fact.amount.Bytes(),
+		fact.hashlock,
+		fact.timeout.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact CreateEscrowFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.receiver, fact.id, fact.amount); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return util.ErrInvalid.Errorf("receiver is same with sender, %v", fact.sender)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("escrow amount should be over zero")
+	}
+
+	if err := types.IsValidEscrowHashlock(fact.hashlock); err != nil {
+		return err
+	}
+
+	if fact.timeout <= base.GenesisHeight {
+		return util.ErrInvalid.Errorf("invalid timeout height, %v", fact.timeout)
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact CreateEscrowFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CreateEscrowFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CreateEscrowFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact CreateEscrowFact) ID() types.EscrowID {
+	return fact.id
+}
+
+func (fact CreateEscrowFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact CreateEscrowFact) Hashlock() []byte {
+	return fact.hashlock
+}
+
+func (fact CreateEscrowFact) Timeout() base.Height {
+	return fact.timeout
+}
+
+// Amounts implements currency.AmountsItem for the fee calculation.
+func (fact CreateEscrowFact) Amounts() []types.Amount {
+	return []types.Amount{fact.amount}
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact CreateEscrowFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact CreateEscrowFact) SetFeeCurrency(cid types.CurrencyID) CreateEscrowFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateEscrowFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact CreateEscrowFact) SetFeePayer(feePayer base.Address) CreateEscrowFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateEscrowFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.receiver}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type CreateEscrow struct {
+	common.BaseOperation
+}
+
+func NewCreateEscrow(fact CreateEscrowFact) (CreateEscrow, error) {
+	return CreateEscrow{BaseOperation: common.NewBaseOperation(CreateEscrowHint, fact)}, nil
+}
+
+func (op *CreateEscrow) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
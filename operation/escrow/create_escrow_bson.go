@@ -0,0 +1,94 @@
+package escrow // nolint: dupl
+
+import (
+	"encoding/hex"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact CreateEscrowFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        fact.Hint().String(),
+			"sender":       fact.sender,
+			"receiver":     fact.receiver,
+			"id":           fact.id,
+			"amount":       fact.amount,
+			"hashlock":     hex.EncodeToString(fact.hashlock),
+			"timeout":      fact.timeout,
+			"fee_currency": fact.feeCurrency,
+			"fee_payer":    fact.feePayer,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
+		},
+	)
+}
+
+type CreateEscrowFactBSONUnmarshaler struct {
+	Hint        string      `bson:"_hint"`
+	Sender      string      `bson:"sender"`
+	Receiver    string      `bson:"receiver"`
+	ID          string      `bson:"id"`
+	Amount      bson.Raw    `bson:"amount"`
+	Hashlock    string      `bson:"hashlock"`
+	Timeout     base.Height `bson:"timeout"`
+	FeeCurrency string      `bson:"fee_currency"`
+	FeePayer    string      `bson:"fee_payer"`
+}
+
+func (fact *CreateEscrowFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CreateEscrowFact")
+
+	var u common.BaseFactBSONUnmarshaler
+
+	err := enc.Unmarshal(b, &u)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(u.Hash))
+	fact.BaseFact.SetToken(u.Token)
+
+	var uf CreateEscrowFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Receiver, uf.ID, uf.Amount, uf.Hashlock, uf.Timeout, uf.FeeCurrency, uf.FeePayer)
+}
+
+func (op CreateEscrow) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *CreateEscrow) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CreateEscrow")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package escrow
+
+import (
+	"encoding/hex"
+
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *CreateEscrowFact) unpack(
+	enc encoder.Encoder,
+	sd, rc, id string,
+	bam []byte,
+	hl string,
+	timeout base.Height,
+	fc, fp string,
+) error {
+	e := util.StringError("failed to unmarshal CreateEscrowFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.receiver = a
+	}
+
+	fact.id = types.EscrowID(id)
+
+	if hinter, err := enc.Decode(bam); err != nil {
+		return e.Wrap(err)
+	} else if am, ok := hinter.(types.Amount); !ok {
+		return e.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		fact.amount = am
+	}
+
+	hashlock, err := hex.DecodeString(hl)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.hashlock = hashlock
+
+	fact.timeout = timeout
+
+	fact.feeCurrency = types.CurrencyID(fc)
+
+	if len(fp) > 0 {
+		switch a, err := base.DecodeAddress(fp, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			fact.feePayer = a
+		}
+	}
+
+	return nil
+}
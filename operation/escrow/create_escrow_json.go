@@ -0,0 +1,86 @@
+package escrow
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type CreateEscrowFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	Receiver    base.Address     `json:"receiver"`
+	ID          types.EscrowID   `json:"id"`
+	Amount      types.Amount     `json:"amount"`
+	Hashlock    string           `json:"hashlock"`
+	Timeout     base.Height      `json:"timeout"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact CreateEscrowFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CreateEscrowFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Receiver:              fact.receiver,
+		ID:                    fact.id,
+		Amount:                fact.amount,
+		Hashlock:              hex.EncodeToString(fact.hashlock),
+		Timeout:               fact.timeout,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type CreateEscrowFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Receiver    string          `json:"receiver"`
+	ID          string          `json:"id"`
+	Amount      json.RawMessage `json:"amount"`
+	Hashlock    string          `json:"hashlock"`
+	Timeout     base.Height     `json:"timeout"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
+}
+
+func (fact *CreateEscrowFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of CreateEscrowFact")
+
+	var uf CreateEscrowFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Receiver, uf.ID, uf.Amount, uf.Hashlock, uf.Timeout, uf.FeeCurrency, uf.FeePayer)
+}
+
+type createEscrowMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op CreateEscrow) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(createEscrowMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CreateEscrow) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode CreateEscrow")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
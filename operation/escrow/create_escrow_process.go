@@ -0,0 +1,147 @@
+package escrow
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/escrow"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var createEscrowProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CreateEscrowProcessor)
+	},
+}
+
+func (CreateEscrow) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CreateEscrowProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCreateEscrowProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new CreateEscrowProcessor")
+
+		nopp := createEscrowProcessorPool.Get()
+		opp, ok := nopp.(*CreateEscrowProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected CreateEscrowProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CreateEscrowProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(CreateEscrowFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("expected CreateEscrowFact, not %T", op.Fact()), nil
+	}
+
+	if fact.timeout <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"timeout height should be over current height, %v <= %v", fact.timeout, opp.Height()), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of sender %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckNotExistsState(extension.StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot create escrow, %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.StateKeyAccount(fact.receiver), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check existence of receiver %v; %w", fact.receiver, err), nil
+	}
+
+	if err := state.CheckExistsState(statecurrency.StateKeyCurrencyDesign(fact.amount.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", fact.amount.Currency(), err), nil
+	}
+
+	if err := state.CheckNotExistsState(escrow.StateKeyEscrow(fact.id), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("escrow already exists, %v; %w", fact.id, err), nil
+	}
+
+	if fact.feePayer != nil {
+		if err := currency.CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CreateEscrowProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(CreateEscrowFact)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected CreateEscrowFact, not %T", op.Fact()), nil
+	}
+
+	feeReceiveBalSts, required, err := currency.CalculateItemsFeeWithFeeCurrency(getStateFunc, []currency.AmountsItem{fact}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	senderBalSts, feePayerBalSts, err := currency.CheckEnoughBalanceWithFeePayer(
+		fact.sender, fact.feePayer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	stmvs, err := currency.PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+
+	stmvs = append(stmvs, state.NewStateMergeValue(
+		escrow.StateKeyEscrow(fact.id),
+		escrow.NewEscrowStateValue(
+			fact.sender, fact.receiver, fact.amount, fact.hashlock, fact.timeout, escrow.EscrowStatusOpen, nil,
+		),
+	))
+
+	return stmvs, nil, nil
+}
+
+func (opp *CreateEscrowProcessor) Close() error {
+	createEscrowProcessorPool.Put(opp)
+
+	return nil
+}
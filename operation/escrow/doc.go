@@ -0,0 +1,4 @@
+/*
+Package escrow provides the hash-time-locked escrow operations.
+*/
+package escrow
@@ -0,0 +1,159 @@
+package escrow
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RefundEscrowFactHint = hint.MustNewHint("mitum-currency-refund-escrow-operation-fact-v0.0.1")
+	RefundEscrowHint     = hint.MustNewHint("mitum-currency-refund-escrow-operation-v0.0.1")
+)
+
+// RefundEscrowFact returns the escrowed amount to the sender of escrow after
+// the timeout height. The whole amount is returned and the fee is charged like
+// the transfer of zero amount of the escrowed currency.
+type RefundEscrowFact struct {
+	base.BaseFact
+	sender      base.Address
+	id          types.EscrowID
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewRefundEscrowFact(token []byte, sender base.Address, id types.EscrowID) RefundEscrowFact {
+	bf := base.NewBaseFact(RefundEscrowFactHint, token)
+	fact := RefundEscrowFact{
+		BaseFact: bf,
+		sender:   sender,
+		id:       id,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RefundEscrowFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RefundEscrowFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RefundEscrowFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.id.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact RefundEscrowFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.id); err != nil {
+		return err
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact RefundEscrowFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RefundEscrowFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RefundEscrowFact) ID() types.EscrowID {
+	return fact.id
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of escrow.
+func (fact RefundEscrowFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact RefundEscrowFact) SetFeeCurrency(cid types.CurrencyID) RefundEscrowFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RefundEscrowFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact RefundEscrowFact) SetFeePayer(feePayer base.Address) RefundEscrowFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RefundEscrowFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+type RefundEscrow struct {
+	common.BaseOperation
+}
+
+func NewRefundEscrow(fact RefundEscrowFact) (RefundEscrow, error) {
+	return RefundEscrow{BaseOperation: common.NewBaseOperation(RefundEscrowHint, fact)}, nil
+}
+
+func (op *RefundEscrow) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package escrow
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RefundEscrowFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	ID          types.EscrowID   `json:"id"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact RefundEscrowFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RefundEscrowFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		ID:                    fact.id,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type RefundEscrowFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string `json:"sender"`
+	ID          string `json:"id"`
+	FeeCurrency string `json:"fee_currency"`
+	FeePayer    string `json:"fee_payer"`
+}
+
+func (fact *RefundEscrowFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RefundEscrowFact")
+
+	var uf RefundEscrowFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.ID, uf.FeeCurrency, uf.FeePayer)
+}
+
+type refundEscrowMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RefundEscrow) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(refundEscrowMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RefundEscrow) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode RefundEscrow")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
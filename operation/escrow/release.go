@@ -0,0 +1,85 @@
+package escrow
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// payEscrowFee charges the fee of ClaimEscrow and RefundEscrow to payer. The
+// fee is charged like the transfer of zero amount of the escrowed currency.
+func payEscrowFee(
+	payer base.Address, cid, feeCurrency types.CurrencyID, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	feeReceiveBalSts, required, err := currency.CalculateItemsFeeWithFeeCurrency(
+		getStateFunc, []currency.AmountsItem{feeAmountsItem{types.NewZeroAmount(cid)}}, feeCurrency)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to calculate fee")
+	}
+
+	payerBalSts, err := currency.CheckEnoughBalance(payer, required, getStateFunc)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to check fee payer balance, %v", payer)
+	}
+
+	stmvs, err := currency.PayRequired(payerBalSts, nil, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to pay required")
+	}
+
+	return stmvs, nil
+}
+
+// releaseEscrow pays the escrowed amount to the balance of to. The fee of the
+// escrowed amount is already paid by CreateEscrow, so the whole amount is
+// paid. If stmvs already has the balance of to, the amount is added to it.
+func releaseEscrow(
+	stmvs []base.StateMergeValue, to base.Address, amount types.Amount, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	k := statecurrency.StateKeyBalance(to, amount.Currency())
+
+	for i := range stmvs {
+		if stmvs[i].Key() != k {
+			continue
+		}
+
+		v, ok := stmvs[i].Value().(statecurrency.BalanceStateValue)
+		if !ok {
+			return nil, errors.Errorf("expected BalanceStateValue, not %T", stmvs[i].Value())
+		}
+
+		stmvs[i] = state.NewStateMergeValue(
+			k, statecurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(amount.Big()))))
+
+		return stmvs, nil
+	}
+
+	var ab types.Amount
+	switch st, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, errors.WithMessagef(err, "failed to find balance state, %v", k)
+	case !found:
+		ab = types.NewZeroAmount(amount.Currency())
+	default:
+		b, err := statecurrency.StateBalanceValue(st)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to get balance value, %v", k)
+		}
+		ab = b
+	}
+
+	return append(stmvs,
+		state.NewStateMergeValue(k, statecurrency.NewBalanceStateValue(ab.WithBig(ab.Big().Add(amount.Big())))),
+	), nil
+}
+
+// feeAmountsItem is the AmountsItem for the facts, which have no items, but
+// are charged the fee like the transfer of the amounts.
+type feeAmountsItem []types.Amount
+
+func (ams feeAmountsItem) Amounts() []types.Amount {
+	return ams
+}
@@ -30,8 +30,10 @@ type CreateContractAccountItem interface {
 
 type CreateContractAccountFact struct {
 	base.BaseFact
-	sender base.Address
-	items  []CreateContractAccountItem
+	sender      base.Address
+	items       []CreateContractAccountItem
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
 }
 
 func NewCreateContractAccountFact(token []byte, sender base.Address, items []CreateContractAccountItem) CreateContractAccountFact {
@@ -60,10 +62,22 @@ func (fact CreateContractAccountFact) Bytes() []byte {
 		is[i] = fact.items[i].Bytes()
 	}
 
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(is...),
+		fc,
+		fp,
 	)
 }
 
@@ -108,6 +122,22 @@ func (fact CreateContractAccountFact) IsValid(b []byte) error {
 		}
 	}
 
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
 	return nil
 }
 
@@ -147,9 +177,37 @@ func (fact CreateContractAccountFact) Addresses() ([]base.Address, error) {
 
 	as[len(fact.items)] = fact.sender
 
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact CreateContractAccountFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact CreateContractAccountFact) SetFeeCurrency(cid types.CurrencyID) CreateContractAccountFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateContractAccountFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact CreateContractAccountFact) SetFeePayer(feePayer base.Address) CreateContractAccountFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
 func (fact CreateContractAccountFact) Rebuild() CreateContractAccountFact {
 	items := make([]CreateContractAccountItem, len(fact.items))
 	for i := range fact.items {
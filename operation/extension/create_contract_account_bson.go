@@ -12,19 +12,23 @@ import (
 func (fact CreateContractAccountFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":  fact.Hint().String(),
-			"sender": fact.sender,
-			"items":  fact.items,
-			"hash":   fact.BaseFact.Hash().String(),
-			"token":  fact.BaseFact.Token(),
+			"_hint":        fact.Hint().String(),
+			"sender":       fact.sender,
+			"items":        fact.items,
+			"fee_currency": fact.feeCurrency,
+			"fee_payer":    fact.feePayer,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
 		},
 	)
 }
 
 type CreateContractAccountFactBSONUnmarshaler struct {
-	Hint   string   `bson:"_hint"`
-	Sender string   `bson:"sender"`
-	Items  bson.Raw `bson:"items"`
+	Hint        string   `bson:"_hint"`
+	Sender      string   `bson:"sender"`
+	Items       bson.Raw `bson:"items"`
+	FeeCurrency string   `bson:"fee_currency"`
+	FeePayer    string   `bson:"fee_payer"`
 }
 
 func (fact *CreateContractAccountFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -50,7 +54,7 @@ func (fact *CreateContractAccountFact) DecodeBSON(b []byte, enc *bsonenc.Encoder
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	return fact.unpack(enc, uf.Sender, uf.Items)
+	return fact.unpack(enc, uf.Sender, uf.Items, uf.FeeCurrency, uf.FeePayer)
 }
 
 func (op CreateContractAccount) MarshalBSON() ([]byte, error) {
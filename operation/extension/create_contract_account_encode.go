@@ -1,13 +1,14 @@
 package extension
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/pkg/errors"
 )
 
-func (fact *CreateContractAccountFact) unpack(enc encoder.Encoder, ow string, bit []byte) error {
+func (fact *CreateContractAccountFact) unpack(enc encoder.Encoder, ow string, bit []byte, fc, fp string) error {
 	e := util.StringError("failed to unmarshal CreateContractAccountFact")
 
 	switch a, err := base.DecodeAddress(ow, enc); {
@@ -33,5 +34,16 @@ func (fact *CreateContractAccountFact) unpack(enc encoder.Encoder, ow string, bi
 	}
 	fact.items = items
 
+	fact.feeCurrency = types.CurrencyID(fc)
+
+	if len(fp) > 0 {
+		switch a, err := base.DecodeAddress(fp, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			fact.feePayer = a
+		}
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
@@ -11,8 +12,10 @@ import (
 
 type CreateContractAccountFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Owner base.Address                `json:"sender"`
-	Items []CreateContractAccountItem `json:"items"`
+	Owner       base.Address                `json:"sender"`
+	Items       []CreateContractAccountItem `json:"items"`
+	FeeCurrency types.CurrencyID            `json:"fee_currency,omitempty"`
+	FeePayer    base.Address                `json:"fee_payer,omitempty"`
 }
 
 func (fact CreateContractAccountFact) MarshalJSON() ([]byte, error) {
@@ -20,13 +23,17 @@ func (fact CreateContractAccountFact) MarshalJSON() ([]byte, error) {
 		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
 		Owner:                 fact.sender,
 		Items:                 fact.items,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
 	})
 }
 
 type CreateContractAccountFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Owner string          `json:"sender"`
-	Items json.RawMessage `json:"items"`
+	Owner       string          `json:"sender"`
+	Items       json.RawMessage `json:"items"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
 }
 
 func (fact *CreateContractAccountFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -39,7 +46,7 @@ func (fact *CreateContractAccountFact) DecodeJSON(b []byte, enc *jsonenc.Encoder
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
-	return fact.unpack(enc, uf.Owner, uf.Items)
+	return fact.unpack(enc, uf.Owner, uf.Items, uf.FeeCurrency, uf.FeePayer)
 }
 
 type createContractAccountMarshaler struct {
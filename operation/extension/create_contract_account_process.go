@@ -216,7 +216,13 @@ func (opp *CreateContractAccountProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be create-contract-account sender, %v: %v", fact.sender, err), nil
 	}
 
-	if err := state.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+	if fact.feePayer != nil {
+		if err := currency.CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %v", err), nil
 	}
 
@@ -251,14 +257,14 @@ func (opp *CreateContractAccountProcessor) Process( // nolint:dupl
 	}
 
 	var (
-		senderBalSts, feeReceiveBalSts map[types.CurrencyID]base.State
-		required                       map[types.CurrencyID][2]common.Big
-		err                            error
+		senderBalSts, feePayerBalSts, feeReceiveBalSts map[types.CurrencyID]base.State
+		required                                       map[types.CurrencyID][2]common.Big
+		err                                            error
 	)
 
 	if feeReceiveBalSts, required, err = opp.calculateItemsFee(op, getStateFunc); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %v", err), nil
-	} else if senderBalSts, err = currency.CheckEnoughBalance(fact.sender, required, getStateFunc); err != nil {
+	} else if senderBalSts, feePayerBalSts, err = currency.CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, getStateFunc); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender %s : %v", fact.sender, err), nil
 	} else {
 		opp.required = required
@@ -293,39 +299,12 @@ func (opp *CreateContractAccountProcessor) Process( // nolint:dupl
 		stateMergeValues = append(stateMergeValues, s...)
 	}
 
-	for cid := range senderBalSts {
-		v, ok := senderBalSts[cid].Value().(currencystate.BalanceStateValue)
-		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSts[cid].Value()), nil
-		}
-
-		var stateMergeValue base.StateMergeValue
-		if senderBalSts[cid].Key() == feeReceiveBalSts[cid].Key() {
-			stateMergeValue = state.NewStateMergeValue(
-				senderBalSts[cid].Key(),
-				currencystate.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[cid][0]).Add(opp.required[cid][1]))),
-			)
-		} else {
-			stateMergeValue = state.NewStateMergeValue(
-				senderBalSts[cid].Key(),
-				currencystate.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[cid][0]))),
-			)
-			r, ok := feeReceiveBalSts[cid].Value().(currencystate.BalanceStateValue)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", feeReceiveBalSts[cid].Value()), nil
-			}
-			stateMergeValues = append(
-				stateMergeValues,
-				state.NewStateMergeValue(
-					feeReceiveBalSts[cid].Key(),
-					currencystate.NewBalanceStateValue(r.Amount.WithBig(r.Amount.Big().Add(opp.required[cid][1]))),
-				),
-			)
-		}
-		stateMergeValues = append(stateMergeValues, stateMergeValue)
+	sts, err := currency.PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, opp.required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
 	}
 
-	return stateMergeValues, nil, nil
+	return append(stateMergeValues, sts...), nil, nil
 }
 
 func (opp *CreateContractAccountProcessor) Close() error {
@@ -355,5 +334,5 @@ func (opp *CreateContractAccountProcessor) calculateItemsFee(
 		items[i] = fact.items[i]
 	}
 
-	return currency.CalculateItemsFee(getStateFunc, items)
+	return currency.CalculateItemsFeeWithFeeCurrency(getStateFunc, items, fact.feeCurrency)
 }
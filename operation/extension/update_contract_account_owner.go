@@ -0,0 +1,183 @@
+package extension
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateContractAccountOwnerFactHint = hint.MustNewHint("mitum-currency-contract-account-update-owner-operation-fact-v0.0.1")
+	UpdateContractAccountOwnerHint     = hint.MustNewHint("mitum-currency-contract-account-update-owner-operation-v0.0.1")
+)
+
+type UpdateContractAccountOwnerFact struct {
+	base.BaseFact
+	sender      base.Address
+	contract    base.Address
+	owner       base.Address
+	currency    types.CurrencyID
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewUpdateContractAccountOwnerFact(
+	token []byte,
+	sender, contract, owner base.Address,
+	currency types.CurrencyID,
+) UpdateContractAccountOwnerFact {
+	bf := base.NewBaseFact(UpdateContractAccountOwnerFactHint, token)
+	fact := UpdateContractAccountOwnerFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		owner:    owner,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateContractAccountOwnerFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateContractAccountOwnerFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateContractAccountOwnerFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateContractAccountOwnerFact) Bytes() []byte {
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.owner.Bytes(),
+		fact.currency.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact UpdateContractAccountOwnerFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.owner, fact.currency); err != nil {
+		return err
+	}
+
+	switch {
+	case fact.sender.Equal(fact.contract):
+		return util.ErrInvalid.Errorf("contract address is same with sender, %v", fact.sender)
+	case fact.sender.Equal(fact.owner):
+		return util.ErrInvalid.Errorf("new owner is same with sender, %v", fact.sender)
+	case fact.contract.Equal(fact.owner):
+		return util.ErrInvalid.Errorf("new owner is same with contract address, %v", fact.contract)
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact UpdateContractAccountOwnerFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateContractAccountOwnerFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateContractAccountOwnerFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact UpdateContractAccountOwnerFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateContractAccountOwnerFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.contract, fact.owner}
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact UpdateContractAccountOwnerFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact UpdateContractAccountOwnerFact) SetFeeCurrency(cid types.CurrencyID) UpdateContractAccountOwnerFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateContractAccountOwnerFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact UpdateContractAccountOwnerFact) SetFeePayer(feePayer base.Address) UpdateContractAccountOwnerFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+type UpdateContractAccountOwner struct {
+	common.BaseOperation
+}
+
+func NewUpdateContractAccountOwner(fact UpdateContractAccountOwnerFact) (UpdateContractAccountOwner, error) {
+	return UpdateContractAccountOwner{BaseOperation: common.NewBaseOperation(UpdateContractAccountOwnerHint, fact)}, nil
+}
+
+func (op *UpdateContractAccountOwner) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
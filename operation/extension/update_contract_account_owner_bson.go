@@ -0,0 +1,80 @@
+package extension // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact UpdateContractAccountOwnerFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        fact.Hint().String(),
+			"sender":       fact.sender,
+			"contract":     fact.contract,
+			"owner":        fact.owner,
+			"currency":     fact.currency,
+			"fee_currency": fact.feeCurrency,
+			"fee_payer":    fact.feePayer,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
+		},
+	)
+}
+
+type UpdateContractAccountOwnerFactBSONUnmarshaler struct {
+	Hint        string `bson:"_hint"`
+	Sender      string `bson:"sender"`
+	Contract    string `bson:"contract"`
+	Owner       string `bson:"owner"`
+	Currency    string `bson:"currency"`
+	FeeCurrency string `bson:"fee_currency"`
+	FeePayer    string `bson:"fee_payer"`
+}
+
+func (fact *UpdateContractAccountOwnerFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of UpdateContractAccountOwnerFact")
+
+	var ubf common.BaseFactBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf UpdateContractAccountOwnerFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.Owner, uf.Currency, uf.FeeCurrency, uf.FeePayer)
+}
+
+func (op UpdateContractAccountOwner) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(op.BaseOperation)
+}
+
+func (op *UpdateContractAccountOwner) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of UpdateContractAccountOwner")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package extension
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateContractAccountOwnerFact) unpack(enc encoder.Encoder, sd, ct, ow, cid, fc, fp string) error {
+	e := util.StringError("failed to unmarshal UpdateContractAccountOwnerFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ct, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(ow, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.owner = a
+	}
+
+	fact.currency = types.CurrencyID(cid)
+
+	fact.feeCurrency = types.CurrencyID(fc)
+
+	if len(fp) > 0 {
+		switch a, err := base.DecodeAddress(fp, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			fact.feePayer = a
+		}
+	}
+
+	return nil
+}
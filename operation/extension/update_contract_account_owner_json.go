@@ -0,0 +1,77 @@
+package extension
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateContractAccountOwnerFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	Contract    base.Address     `json:"contract"`
+	Owner       base.Address     `json:"owner"`
+	Currency    types.CurrencyID `json:"currency"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact UpdateContractAccountOwnerFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateContractAccountOwnerFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Owner:                 fact.owner,
+		Currency:              fact.currency,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type UpdateContractAccountOwnerFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string `json:"sender"`
+	Contract    string `json:"contract"`
+	Owner       string `json:"owner"`
+	Currency    string `json:"currency"`
+	FeeCurrency string `json:"fee_currency"`
+	FeePayer    string `json:"fee_payer"`
+}
+
+func (fact *UpdateContractAccountOwnerFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateContractAccountOwnerFact")
+
+	var uf UpdateContractAccountOwnerFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.Owner, uf.Currency, uf.FeeCurrency, uf.FeePayer)
+}
+
+type updateContractAccountOwnerMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateContractAccountOwner) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(updateContractAccountOwnerMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateContractAccountOwner) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateContractAccountOwner")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package extension
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateOperatorFactHint = hint.MustNewHint("mitum-currency-contract-account-update-operator-operation-fact-v0.0.1")
+	UpdateOperatorHint     = hint.MustNewHint("mitum-currency-contract-account-update-operator-operation-v0.0.1")
+)
+
+var MaxOperators uint = 10
+
+type UpdateOperatorFact struct {
+	base.BaseFact
+	sender      base.Address
+	contract    base.Address
+	operators   []base.Address
+	currency    types.CurrencyID
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
+}
+
+func NewUpdateOperatorFact(
+	token []byte,
+	sender, contract base.Address,
+	operators []base.Address,
+	currency types.CurrencyID,
+) UpdateOperatorFact {
+	bf := base.NewBaseFact(UpdateOperatorFactHint, token)
+	fact := UpdateOperatorFact{
+		BaseFact:  bf,
+		sender:    sender,
+		contract:  contract,
+		operators: operators,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateOperatorFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateOperatorFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateOperatorFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateOperatorFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.operators))
+	for i := range fact.operators {
+		bs[i] = fact.operators[i].Bytes()
+	}
+
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+		fc,
+		fp,
+	)
+}
+
+func (fact UpdateOperatorFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.operators); n > int(MaxOperators) {
+		return util.ErrInvalid.Errorf("operators, %d over max, %d", n, MaxOperators)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %v", fact.sender)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.operators {
+		op := fact.operators[i]
+		if err := util.CheckIsValiders(nil, false, op); err != nil {
+			return err
+		}
+
+		switch _, found := founds[op.String()]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate operator found, %v", op)
+		case fact.contract.Equal(op):
+			return util.ErrInvalid.Errorf("operator is same with contract address, %v", fact.contract)
+		default:
+			founds[op.String()] = struct{}{}
+		}
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
+	return nil
+}
+
+func (fact UpdateOperatorFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateOperatorFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateOperatorFact) Operators() []base.Address {
+	return fact.operators
+}
+
+func (fact UpdateOperatorFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateOperatorFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.operators)+2)
+	copy(as, fact.operators)
+
+	as[len(fact.operators)] = fact.sender
+	as[len(fact.operators)+1] = fact.contract
+
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
+	return as, nil
+}
+
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact UpdateOperatorFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact UpdateOperatorFact) SetFeeCurrency(cid types.CurrencyID) UpdateOperatorFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateOperatorFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact UpdateOperatorFact) SetFeePayer(feePayer base.Address) UpdateOperatorFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+type UpdateOperator struct {
+	common.BaseOperation
+}
+
+func NewUpdateOperator(fact UpdateOperatorFact) (UpdateOperator, error) {
+	return UpdateOperator{BaseOperation: common.NewBaseOperation(UpdateOperatorHint, fact)}, nil
+}
+
+func (op *UpdateOperator) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package extension
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateOperatorFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address     `json:"sender"`
+	Contract    base.Address     `json:"contract"`
+	Operators   []base.Address   `json:"operators"`
+	Currency    types.CurrencyID `json:"currency"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
+}
+
+func (fact UpdateOperatorFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateOperatorFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Operators:             fact.operators,
+		Currency:              fact.currency,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
+	})
+}
+
+type UpdateOperatorFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string   `json:"sender"`
+	Contract    string   `json:"contract"`
+	Operators   []string `json:"operators"`
+	Currency    string   `json:"currency"`
+	FeeCurrency string   `json:"fee_currency"`
+	FeePayer    string   `json:"fee_payer"`
+}
+
+func (fact *UpdateOperatorFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateOperatorFact")
+
+	var uf UpdateOperatorFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.Operators, uf.Currency, uf.FeeCurrency, uf.FeePayer)
+}
+
+type updateOperatorMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateOperator) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(updateOperatorMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateOperator) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateOperator")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package extension
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var updateOperatorProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateOperatorProcessor)
+	},
+}
+
+func (UpdateOperator) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type UpdateOperatorProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateOperatorProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateOperatorProcessor")
+
+		nopp := updateOperatorProcessorPool.Get()
+		opp, ok := nopp.(*UpdateOperatorProcessor)
+		if !ok {
+			return nil, e.Errorf("expected UpdateOperatorProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateOperatorProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess UpdateOperator")
+
+	fact, ok := op.Fact().(UpdateOperatorFact)
+	if !ok {
+		return ctx, nil, e.Errorf("expected UpdateOperatorFact, not %T", op.Fact())
+	}
+
+	if err := state.CheckExistsState(statecurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %v; %w", fact.sender, err), nil
+	}
+
+	if err := state.CheckNotExistsState(extension.StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be sender, %v; %w", fact.sender, err), nil
+	}
+
+	st, err := state.ExistsState(extension.StateKeyContractAccount(fact.contract), "key of contract account", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account not found, %v; %w", fact.contract, err), nil
+	}
+
+	cs, err := extension.StateContractAccountValue(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to get contract account status, %v; %w", fact.contract, err), nil
+	}
+
+	if !cs.Owner().Equal(fact.sender) {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account owner is not matched with %v", fact.sender), nil
+	}
+
+	for i := range fact.operators {
+		if err := state.CheckExistsState(statecurrency.StateKeyAccount(fact.operators[i]), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("operator not found, %v; %w", fact.operators[i], err), nil
+		}
+
+		if err := state.CheckNotExistsState(extension.StateKeyContractAccount(fact.operators[i]), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be operator, %v; %w", fact.operators[i], err), nil
+		}
+	}
+
+	if _, err := state.ExistsCurrencyPolicy(fact.currency, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %v; %w", fact.currency, err), nil
+	}
+
+	if fact.feePayer != nil {
+		if err := currency.CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateOperatorProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process UpdateOperator")
+
+	fact, ok := op.Fact().(UpdateOperatorFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected UpdateOperatorFact, not %T", op.Fact())
+	}
+
+	st, err := state.ExistsState(extension.StateKeyContractAccount(fact.contract), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account not found, %v; %w", fact.contract, err), nil
+	}
+
+	cs, err := extension.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get contract account status, %v; %w", fact.contract, err), nil
+	}
+
+	ncs, err := cs.SetOperators(fact.operators)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to set operators, %v; %w", fact.contract, err), nil
+	}
+
+	payer := fact.sender
+	if fact.feePayer != nil {
+		payer = fact.feePayer
+	}
+
+	// NOTE the fee is charged like the transfer of zero amount of currency.
+	feeReceiveBalSts, required, err := currency.CalculateItemsFeeWithFeeCurrency(
+		getStateFunc, []currency.AmountsItem{feeAmountsItem{types.NewZeroAmount(fact.currency)}}, fact.feeCurrency)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+
+	payerBalSts, err := currency.CheckEnoughBalance(payer, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee payer balance, %v; %w", payer, err), nil
+	}
+
+	stmvs, err := currency.PayRequired(payerBalSts, nil, feeReceiveBalSts, required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
+	}
+	stmvs = append(stmvs, state.NewStateMergeValue(st.Key(), extension.NewContractAccountStateValue(ncs)))
+
+	return stmvs, nil, nil
+}
+
+func (opp *UpdateOperatorProcessor) Close() error {
+	updateOperatorProcessorPool.Put(opp)
+
+	return nil
+}
+
+// feeAmountsItem is the AmountsItem for the facts, which have no items, but
+// are charged the fee like the transfer of the amounts.
+type feeAmountsItem []types.Amount
+
+func (ams feeAmountsItem) Amounts() []types.Amount {
+	return ams
+}
@@ -3,6 +3,7 @@ package extension
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -27,8 +28,10 @@ type WithdrawItem interface {
 
 type WithdrawFact struct {
 	base.BaseFact
-	sender base.Address
-	items  []WithdrawItem
+	sender      base.Address
+	items       []WithdrawItem
+	feeCurrency types.CurrencyID
+	feePayer    base.Address
 }
 
 func NewWithdrawFact(token []byte, sender base.Address, items []WithdrawItem) WithdrawFact {
@@ -61,10 +64,22 @@ func (fact WithdrawFact) Bytes() []byte {
 		its[i] = fact.items[i].Bytes()
 	}
 
+	var fc []byte
+	if len(fact.feeCurrency) > 0 {
+		fc = fact.feeCurrency.Bytes()
+	}
+
+	var fp []byte
+	if fact.feePayer != nil {
+		fp = fact.feePayer.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
 		util.ConcatBytesSlice(its...),
+		fc,
+		fp,
 	)
 }
 
@@ -105,6 +120,22 @@ func (fact WithdrawFact) IsValid(b []byte) error {
 		}
 	}
 
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.feePayer != nil {
+		if err := fact.feePayer.IsValid(nil); err != nil {
+			return err
+		}
+
+		if fact.feePayer.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("fee payer is same with sender, %v", fact.sender)
+		}
+	}
+
 	return nil
 }
 
@@ -137,9 +168,37 @@ func (fact WithdrawFact) Addresses() ([]base.Address, error) {
 
 	as[len(fact.items)] = fact.Sender()
 
+	if fact.feePayer != nil {
+		as = append(as, fact.feePayer)
+	}
+
 	return as, nil
 }
 
+// FeeCurrency returns the currency which the fee is paid in. Empty means the
+// fee is paid in the currency of the operation.
+func (fact WithdrawFact) FeeCurrency() types.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact WithdrawFact) SetFeeCurrency(cid types.CurrencyID) WithdrawFact {
+	fact.feeCurrency = cid
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact WithdrawFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+func (fact WithdrawFact) SetFeePayer(feePayer base.Address) WithdrawFact {
+	fact.feePayer = feePayer
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
 type Withdraw struct {
 	common.BaseOperation
 }
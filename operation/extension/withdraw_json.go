@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
@@ -11,8 +12,10 @@ import (
 
 type WithdrawFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Sender base.Address   `json:"sender"`
-	Items  []WithdrawItem `json:"items"`
+	Sender      base.Address     `json:"sender"`
+	Items       []WithdrawItem   `json:"items"`
+	FeeCurrency types.CurrencyID `json:"fee_currency,omitempty"`
+	FeePayer    base.Address     `json:"fee_payer,omitempty"`
 }
 
 func (fact WithdrawFact) MarshalJSON() ([]byte, error) {
@@ -20,13 +23,17 @@ func (fact WithdrawFact) MarshalJSON() ([]byte, error) {
 		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
 		Sender:                fact.sender,
 		Items:                 fact.items,
+		FeeCurrency:           fact.feeCurrency,
+		FeePayer:              fact.feePayer,
 	})
 }
 
 type WithdrawFactJSONUnmarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Sender string          `json:"sender"`
-	Items  json.RawMessage `json:"items"`
+	Sender      string          `json:"sender"`
+	Items       json.RawMessage `json:"items"`
+	FeeCurrency string          `json:"fee_currency"`
+	FeePayer    string          `json:"fee_payer"`
 }
 
 func (fact *WithdrawFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -40,7 +47,7 @@ func (fact *WithdrawFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
-	return fact.unpack(enc, uf.Sender, uf.Items)
+	return fact.unpack(enc, uf.Sender, uf.Items, uf.FeeCurrency, uf.FeePayer)
 }
 
 type withdrawMarshaler struct {
@@ -57,8 +57,8 @@ func (opp *WithdrawItemProcessor) PreProcess(
 	if err != nil {
 		return err
 	}
-	if !v.Owner().Equal(opp.sender) {
-		return errors.Errorf("contract account owner is not matched with %v", opp.sender)
+	if !v.Owner().Equal(opp.sender) && !v.IsOperator(opp.sender) {
+		return errors.Errorf("sender is neither owner nor operator of contract account, %v", opp.sender)
 	}
 
 	tb := map[types.CurrencyID]base.StateMergeValue{}
@@ -70,6 +70,10 @@ func (opp *WithdrawItemProcessor) PreProcess(
 			return err
 		}
 
+		if err := state.CheckNotFrozen(opp.item.Target(), am.Currency(), getStateFunc); err != nil {
+			return err
+		}
+
 		st, _, err := getStateFunc(statecurrency.StateKeyBalance(opp.item.Target(), am.Currency()))
 		if err != nil {
 			return err
@@ -165,7 +169,13 @@ func (opp *WithdrawProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be sender, %v; %w", fact.sender, err), nil
 	}
 
-	if err := state.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+	if fact.feePayer != nil {
+		if err := currency.CheckFeePayer(fact.feePayer, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid fee payer; %w", err), nil
+		}
+	}
+
+	if err := state.CheckFactSignsWithFeePayer(fact.sender, fact.feePayer, op.Signs(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
 	}
 
@@ -203,7 +213,7 @@ func (opp *WithdrawProcessor) Process( // nolint:dupl
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %v", err), nil
 	}
-	senderBalSts, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	senderBalSts, feePayerBalSts, err := currency.CheckEnoughBalanceWithFeePayer(fact.sender, fact.feePayer, required, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %v", err), nil
 	} else {
@@ -240,39 +250,12 @@ func (opp *WithdrawProcessor) Process( // nolint:dupl
 		ns[i].Close()
 	}
 
-	for cid := range senderBalSts {
-		v, ok := senderBalSts[cid].Value().(statecurrency.BalanceStateValue)
-		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSts[cid].Value()), nil
-		}
-
-		var stateMergeValue base.StateMergeValue
-		if senderBalSts[cid].Key() == feeReceiveBalSts[cid].Key() {
-			stateMergeValue = state.NewStateMergeValue(
-				senderBalSts[cid].Key(),
-				statecurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[cid][0]).Add(opp.required[cid][1]))),
-			)
-		} else {
-			stateMergeValue = state.NewStateMergeValue(
-				senderBalSts[cid].Key(),
-				statecurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(opp.required[cid][0]))),
-			)
-			r, ok := feeReceiveBalSts[cid].Value().(statecurrency.BalanceStateValue)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", feeReceiveBalSts[cid].Value()), nil
-			}
-			stateMergeValues = append(
-				stateMergeValues,
-				state.NewStateMergeValue(
-					feeReceiveBalSts[cid].Key(),
-					statecurrency.NewBalanceStateValue(r.Amount.WithBig(r.Amount.Big().Add(opp.required[cid][1]))),
-				),
-			)
-		}
-		stateMergeValues = append(stateMergeValues, stateMergeValue)
+	sts, err := currency.PayRequired(senderBalSts, feePayerBalSts, feeReceiveBalSts, opp.required)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay required; %w", err), nil
 	}
 
-	return stateMergeValues, nil, nil
+	return append(stateMergeValues, sts...), nil, nil
 }
 
 func (opp *WithdrawProcessor) Close() error {
@@ -296,5 +279,5 @@ func (opp *WithdrawProcessor) calculateItemsFee(op base.Operation, getStateFunc
 		items[i] = fact.items[i]
 	}
 
-	return currency.CalculateItemsFee(getStateFunc, items)
+	return currency.CalculateItemsFeeWithFeeCurrency(getStateFunc, items, fact.feeCurrency)
 }
@@ -6,8 +6,11 @@ import (
 	"io"
 	"sync"
 
+	"github.com/ProtoconNet/mitum-currency/v3/operation/batch"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/escrow"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/extension"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 
@@ -29,6 +32,10 @@ type GetLastBlockFunc func() (base.BlockMap, bool, error)
 const (
 	DuplicationTypeSender   types.DuplicationType = "sender"
 	DuplicationTypeCurrency types.DuplicationType = "currency"
+	// DuplicationTypeStandingOrder allows only one operation for a standing
+	// order in proposal; the registration and the cancellation of the same
+	// order can not be merged.
+	DuplicationTypeStandingOrder types.DuplicationType = "standing-order"
 )
 
 type BaseOperationProcessor interface {
@@ -231,6 +238,52 @@ func CheckDuplication(opr *OperationProcessor, op base.Operation) error {
 		newAddresses = as
 		// did = fact.Target().String()
 		// didtype = DuplicationTypeSender
+	case batch.Batch:
+		fact, ok := t.Fact().(batch.BatchFact)
+		if !ok {
+			return errors.Errorf("expected BatchFact, not %T", t.Fact())
+		}
+		for _, it := range fact.Items() {
+			var as []base.Address
+			var err error
+
+			switch i := it.(type) {
+			case currency.CreateAccountFact:
+				as, err = i.Targets()
+			case currency.UpdateKeyFact:
+				as, err = i.Addresses()
+			default:
+				continue
+			}
+
+			if err != nil {
+				return errors.Errorf("failed to get Addresses")
+			}
+			newAddresses = append(newAddresses, as...)
+		}
+	case currency.RegisterStandingOrder:
+		fact, ok := t.Fact().(currency.RegisterStandingOrderFact)
+		if !ok {
+			return errors.Errorf("expected RegisterStandingOrderFact, not %T", t.Fact())
+		}
+		if err := opr.checkStandingOrderDuplication(
+			statecurrency.StateKeyStandingOrder(fact.Sender(), fact.ID())); err != nil {
+			return err
+		}
+	case currency.CancelStandingOrder:
+		fact, ok := t.Fact().(currency.CancelStandingOrderFact)
+		if !ok {
+			return errors.Errorf("expected CancelStandingOrderFact, not %T", t.Fact())
+		}
+		if err := opr.checkStandingOrderDuplication(
+			statecurrency.StateKeyStandingOrder(fact.Sender(), fact.ID())); err != nil {
+			return err
+		}
+	case currency.PayStandingOrders:
+		// NOTE the due standing orders are paid once in proposal.
+		if err := opr.checkStandingOrderDuplication(statecurrency.StateKeyStandingOrderCursor); err != nil {
+			return err
+		}
 	// case Transfer:
 	// 	fact, ok := t.Fact().(TransferFact)
 	// 	if !ok {
@@ -294,6 +347,16 @@ func (opr *OperationProcessor) CheckNewAddressDuplication(as []base.Address) err
 	return nil
 }
 
+func (opr *OperationProcessor) checkStandingOrderDuplication(key string) error {
+	if _, found := opr.Duplicated[key]; found {
+		return errors.Errorf("duplicated standing order, %v found in proposal", key)
+	}
+
+	opr.Duplicated[key] = DuplicationTypeStandingOrder
+
+	return nil
+}
+
 func (opr *OperationProcessor) Close() error {
 	opr.Lock()
 	defer opr.Unlock()
@@ -321,20 +384,61 @@ func GetNewProcessor(opr *OperationProcessor, op base.Operation) (base.Operation
 	}
 
 	switch t := op.(type) {
+	case batch.Batch:
+		return opr.newBatchProcessor(op)
 	case currency.CreateAccount,
 		currency.UpdateKey,
 		currency.Transfer,
 		currency.RegisterCurrency,
 		currency.UpdateCurrency,
+		currency.UpdateFeeRate,
+		currency.Freeze,
 		currency.Mint,
+		currency.Burn,
+		currency.CreateVesting,
+		currency.ClaimVesting,
+		currency.Approve,
+		currency.TransferFrom,
+		currency.RegisterStandingOrder,
+		currency.CancelStandingOrder,
+		currency.PayStandingOrders,
 		extension.CreateContractAccount,
-		extension.Withdraw:
+		extension.Withdraw,
+		extension.UpdateOperator,
+		extension.UpdateContractAccountOwner,
+		escrow.CreateEscrow,
+		escrow.ClaimEscrow,
+		escrow.RefundEscrow:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return nil, false, nil
 	}
 }
 
+// newBatchProcessor creates the processor of batch, which gets the processors
+// of the batch items by GetNewProcessorFunc. The processor is created once for
+// the batch; PreProcess and Process share it, so it is closed once.
+func (opr *OperationProcessor) newBatchProcessor(op base.Operation) (base.OperationProcessor, bool, error) {
+	h := op.(util.Hasher).Hash().String()
+
+	if i, found := opr.processorClosers.Load(h); found {
+		if opp, ok := i.(*batch.BatchProcessor); ok {
+			return opp, true, nil
+		}
+	}
+
+	opp, err := batch.NewBatchProcessor(func(iop base.Operation) (base.OperationProcessor, bool, error) {
+		return opr.GetNewProcessorFunc(opr, iop)
+	})(opr.Height(), opr.GetStateFunc, nil, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	opr.processorClosers.Store(h, opp)
+
+	return opp, true, nil
+}
+
 func (opr *OperationProcessor) GetNewProcessorFromHintset(op base.Operation) (base.OperationProcessor, error) {
 	var f types.GetNewProcessor
 	if hinter, ok := op.(hint.Hinter); !ok {
@@ -2,24 +2,40 @@ package currency
 
 import (
 	"fmt"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/pkg/errors"
+	"sort"
 	"strings"
 )
 
 var (
-	AccountStateValueHint        = hint.MustNewHint("account-state-value-v0.0.1")
-	BalanceStateValueHint        = hint.MustNewHint("balance-state-value-v0.0.1")
-	CurrencyDesignStateValueHint = hint.MustNewHint("currency-design-state-value-v0.0.1")
+	AccountStateValueHint             = hint.MustNewHint("account-state-value-v0.0.1")
+	BalanceStateValueHint             = hint.MustNewHint("balance-state-value-v0.0.1")
+	CurrencyDesignStateValueHint      = hint.MustNewHint("currency-design-state-value-v0.0.1")
+	FeeRateStateValueHint             = hint.MustNewHint("fee-rate-state-value-v0.0.1")
+	FrozenStateValueHint              = hint.MustNewHint("frozen-state-value-v0.0.1")
+	VestingStateValueHint             = hint.MustNewHint("vesting-state-value-v0.0.1")
+	AllowanceStateValueHint           = hint.MustNewHint("allowance-state-value-v0.0.1")
+	StandingOrderStateValueHint       = hint.MustNewHint("standing-order-state-value-v0.0.1")
+	StandingOrderDueStateValueHint    = hint.MustNewHint("standing-order-due-state-value-v0.0.1")
+	StandingOrderCursorStateValueHint = hint.MustNewHint("standing-order-cursor-state-value-v0.0.1")
 )
 
 var (
-	StateKeyAccountSuffix        = ":account"
-	StateKeyBalanceSuffix        = ":balance"
-	StateKeyCurrencyDesignPrefix = "currencydesign:"
+	StateKeyAccountSuffix          = ":account"
+	StateKeyBalanceSuffix          = ":balance"
+	StateKeyCurrencyDesignPrefix   = "currencydesign:"
+	StateKeyFeeRatePrefix          = "feerate:"
+	StateKeyFrozenSuffix           = ":frozen"
+	StateKeyVestingSuffix          = ":vesting"
+	StateKeyAllowanceSuffix        = ":allowance"
+	StateKeyStandingOrderSuffix    = ":standingorder"
+	StateKeyStandingOrderDuePrefix = "standingorder:due:"
+	StateKeyStandingOrderCursor    = "standingorder:cursor"
 )
 
 type AccountStateValue struct {
@@ -160,6 +176,805 @@ func StateCurrencyDesignValue(st base.State) (types.CurrencyDesign, error) {
 	return de.CurrencyDesign, nil
 }
 
+// FeeRateStateValue keeps the rate to convert a fee of Currency into
+// FeeCurrency. Zero rate means FeeCurrency is not accepted for Currency.
+type FeeRateStateValue struct {
+	hint.BaseHinter
+	Currency    types.CurrencyID
+	FeeCurrency types.CurrencyID
+	Rate        types.FeeRate
+}
+
+func NewFeeRateStateValue(cid, feeCurrency types.CurrencyID, rate types.FeeRate) FeeRateStateValue {
+	return FeeRateStateValue{
+		BaseHinter:  hint.NewBaseHinter(FeeRateStateValueHint),
+		Currency:    cid,
+		FeeCurrency: feeCurrency,
+		Rate:        rate,
+	}
+}
+
+func (f FeeRateStateValue) Hint() hint.Hint {
+	return f.BaseHinter.Hint()
+}
+
+func (f FeeRateStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid FeeRateStateValue")
+
+	if err := f.BaseHinter.IsValid(FeeRateStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, f.Currency, f.FeeCurrency, f.Rate); err != nil {
+		return e.Wrap(err)
+	}
+
+	if f.Currency == f.FeeCurrency {
+		return e.Wrap(errors.Errorf("fee currency is same with currency, %v", f.Currency))
+	}
+
+	return nil
+}
+
+func (f FeeRateStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		f.Currency.Bytes(),
+		f.FeeCurrency.Bytes(),
+		f.Rate.Bytes(),
+	)
+}
+
+func StateFeeRateValue(st base.State) (FeeRateStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return FeeRateStateValue{}, util.ErrNotFound.Errorf("fee rate not found in State")
+	}
+
+	fr, ok := v.(FeeRateStateValue)
+	if !ok {
+		return FeeRateStateValue{}, errors.Errorf("invalid fee rate value found, %T", v)
+	}
+
+	return fr, nil
+}
+
+// FrozenStateValue marks the balance of Currency frozen. The frozen balance can
+// be credited, but can not be debited.
+type FrozenStateValue struct {
+	hint.BaseHinter
+	Currency types.CurrencyID
+	Frozen   bool
+}
+
+func NewFrozenStateValue(cid types.CurrencyID, frozen bool) FrozenStateValue {
+	return FrozenStateValue{
+		BaseHinter: hint.NewBaseHinter(FrozenStateValueHint),
+		Currency:   cid,
+		Frozen:     frozen,
+	}
+}
+
+func (f FrozenStateValue) Hint() hint.Hint {
+	return f.BaseHinter.Hint()
+}
+
+func (f FrozenStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid FrozenStateValue")
+
+	if err := f.BaseHinter.IsValid(FrozenStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := f.Currency.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (f FrozenStateValue) HashBytes() []byte {
+	fb := []byte{0}
+	if f.Frozen {
+		fb = []byte{1}
+	}
+
+	return util.ConcatBytesSlice(f.Currency.Bytes(), fb)
+}
+
+func StateFrozenValue(st base.State) (FrozenStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return FrozenStateValue{}, util.ErrNotFound.Errorf("frozen not found in State")
+	}
+
+	f, ok := v.(FrozenStateValue)
+	if !ok {
+		return FrozenStateValue{}, errors.Errorf("invalid frozen value found, %T", v)
+	}
+
+	return f, nil
+}
+
+// VestingStateValue keeps the locked Amount, which is released linearly from
+// Start to End height. Nothing can be claimed before Cliff height.
+// VestingStateValue keeps the vesting of Amount from Sender to Receiver; each
+// sender has its own vesting for the receiver.
+type VestingStateValue struct {
+	hint.BaseHinter
+	Sender   base.Address
+	Receiver base.Address
+	Amount   types.Amount
+	Claimed  common.Big
+	Start    base.Height
+	Cliff    base.Height
+	End      base.Height
+}
+
+func NewVestingStateValue(
+	sender, receiver base.Address, amount types.Amount, claimed common.Big, start, cliff, end base.Height,
+) VestingStateValue {
+	return VestingStateValue{
+		BaseHinter: hint.NewBaseHinter(VestingStateValueHint),
+		Sender:     sender,
+		Receiver:   receiver,
+		Amount:     amount,
+		Claimed:    claimed,
+		Start:      start,
+		Cliff:      cliff,
+		End:        end,
+	}
+}
+
+func (v VestingStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v VestingStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid VestingStateValue")
+
+	if err := v.BaseHinter.IsValid(VestingStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Sender, v.Receiver, v.Amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	switch {
+	case !v.Claimed.OverNil():
+		return e.Wrap(errors.Errorf("claimed under zero"))
+	case v.Claimed.Compare(v.Amount.Big()) > 0:
+		return e.Wrap(errors.Errorf("claimed over amount, %v > %v", v.Claimed, v.Amount.Big()))
+	}
+
+	if err := types.IsValidVestingSchedule(v.Start, v.Cliff, v.End); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v VestingStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Sender.Bytes(),
+		v.Receiver.Bytes(),
+		v.Amount.Bytes(),
+		v.Claimed.Bytes(),
+		v.Start.Bytes(),
+		v.Cliff.Bytes(),
+		v.End.Bytes(),
+	)
+}
+
+// Vested returns the released amount at the height, including the claimed.
+func (v VestingStateValue) Vested(height base.Height) common.Big {
+	switch {
+	case height < v.Cliff:
+		return common.ZeroBig
+	case height >= v.End:
+		return v.Amount.Big()
+	default:
+		return v.Amount.Big().
+			Mul(common.NewBig(int64(height - v.Start))).
+			Div(common.NewBig(int64(v.End - v.Start)))
+	}
+}
+
+// Claimable returns the released amount at the height, which is not claimed
+// yet.
+func (v VestingStateValue) Claimable(height base.Height) common.Big {
+	return v.Vested(height).Sub(v.Claimed)
+}
+
+// Locked returns the amount, which is not claimed yet.
+func (v VestingStateValue) Locked() common.Big {
+	return v.Amount.Big().Sub(v.Claimed)
+}
+
+func StateVestingValue(st base.State) (VestingStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return VestingStateValue{}, util.ErrNotFound.Errorf("vesting not found in State")
+	}
+
+	vs, ok := v.(VestingStateValue)
+	if !ok {
+		return VestingStateValue{}, errors.Errorf("invalid vesting value found, %T", v)
+	}
+
+	return vs, nil
+}
+
+// AllowanceStateValue keeps the Amount which Spender can transfer from the
+// balance of Owner.
+type AllowanceStateValue struct {
+	hint.BaseHinter
+	Owner   base.Address
+	Spender base.Address
+	Amount  types.Amount
+}
+
+func NewAllowanceStateValue(owner, spender base.Address, amount types.Amount) AllowanceStateValue {
+	return AllowanceStateValue{
+		BaseHinter: hint.NewBaseHinter(AllowanceStateValueHint),
+		Owner:      owner,
+		Spender:    spender,
+		Amount:     amount,
+	}
+}
+
+func (a AllowanceStateValue) Hint() hint.Hint {
+	return a.BaseHinter.Hint()
+}
+
+func (a AllowanceStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid AllowanceStateValue")
+
+	if err := a.BaseHinter.IsValid(AllowanceStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, a.Owner, a.Spender, a.Amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	if a.Owner.Equal(a.Spender) {
+		return e.Wrap(errors.Errorf("spender is same with owner, %v", a.Owner))
+	}
+
+	return nil
+}
+
+func (a AllowanceStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(a.Owner.Bytes(), a.Spender.Bytes(), a.Amount.Bytes())
+}
+
+func StateAllowanceValue(st base.State) (AllowanceStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return AllowanceStateValue{}, util.ErrNotFound.Errorf("allowance not found in State")
+	}
+
+	a, ok := v.(AllowanceStateValue)
+	if !ok {
+		return AllowanceStateValue{}, errors.Errorf("invalid allowance value found, %T", v)
+	}
+
+	return a, nil
+}
+
+// StandingOrderStateValue keeps the schedule of payments from Sender to
+// Receiver; Amount is due at Start, Start+Interval, ... for Count times, and
+// Paid is the number of the executed payments. The cancelled order is not paid
+// anymore.
+type StandingOrderStateValue struct {
+	hint.BaseHinter
+	Sender    base.Address
+	Receiver  base.Address
+	Amount    types.Amount
+	Start     base.Height
+	Interval  uint64
+	Count     uint64
+	Paid      uint64
+	Cancelled bool
+}
+
+func NewStandingOrderStateValue(
+	sender, receiver base.Address, amount types.Amount, start base.Height, interval, count, paid uint64,
+) StandingOrderStateValue {
+	return StandingOrderStateValue{
+		BaseHinter: hint.NewBaseHinter(StandingOrderStateValueHint),
+		Sender:     sender,
+		Receiver:   receiver,
+		Amount:     amount,
+		Start:      start,
+		Interval:   interval,
+		Count:      count,
+		Paid:       paid,
+	}
+}
+
+func (s StandingOrderStateValue) Hint() hint.Hint {
+	return s.BaseHinter.Hint()
+}
+
+func (s StandingOrderStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid StandingOrderStateValue")
+
+	if err := s.BaseHinter.IsValid(StandingOrderStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, s.Sender, s.Receiver, s.Amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := types.IsValidStandingOrderSchedule(s.Start, s.Interval, s.Count); err != nil {
+		return e.Wrap(err)
+	}
+
+	if s.Paid > s.Count {
+		return e.Wrap(errors.Errorf("paid over count, %d > %d", s.Paid, s.Count))
+	}
+
+	return nil
+}
+
+func (s StandingOrderStateValue) HashBytes() []byte {
+	v := []byte{0}
+	if s.Cancelled {
+		v = []byte{1}
+	}
+
+	return util.ConcatBytesSlice(
+		s.Sender.Bytes(),
+		s.Receiver.Bytes(),
+		s.Amount.Bytes(),
+		s.Start.Bytes(),
+		util.Uint64ToBytes(s.Interval),
+		util.Uint64ToBytes(s.Count),
+		util.Uint64ToBytes(s.Paid),
+		v,
+	)
+}
+
+// Due returns the number of the payments, which are due at the height and not
+// paid yet.
+func (s StandingOrderStateValue) Due(height base.Height) uint64 {
+	if s.Cancelled || height < s.Start {
+		return 0
+	}
+
+	n := uint64(height-s.Start)/s.Interval + 1
+	if n > s.Count {
+		n = s.Count
+	}
+
+	return n - s.Paid
+}
+
+// Finished returns true when all the payments are paid or the order is
+// cancelled.
+func (s StandingOrderStateValue) Finished() bool {
+	return s.Cancelled || s.Paid >= s.Count
+}
+
+// NextDue returns the height of the next payment; the bool is false when the
+// order is finished.
+func (s StandingOrderStateValue) NextDue() (base.Height, bool) {
+	if s.Finished() {
+		return base.NilHeight, false
+	}
+
+	return s.Start + base.Height(s.Paid*s.Interval), true
+}
+
+// Pay returns the value, which paid n more payments.
+func (s StandingOrderStateValue) Pay(n uint64) StandingOrderStateValue {
+	s.Paid += n
+
+	return s
+}
+
+// Cancel returns the cancelled value.
+func (s StandingOrderStateValue) Cancel() StandingOrderStateValue {
+	s.Cancelled = true
+
+	return s
+}
+
+func StateStandingOrderValue(st base.State) (StandingOrderStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return StandingOrderStateValue{}, util.ErrNotFound.Errorf("standing order not found in State")
+	}
+
+	s, ok := v.(StandingOrderStateValue)
+	if !ok {
+		return StandingOrderStateValue{}, errors.Errorf("invalid standing order value found, %T", v)
+	}
+
+	return s, nil
+}
+
+// StandingOrderDueStateValue keeps the state keys of the standing orders,
+// which are due at the height of the state key, sorted.
+type StandingOrderDueStateValue struct {
+	hint.BaseHinter
+	Keys []string
+}
+
+func NewStandingOrderDueStateValue(keys []string) StandingOrderDueStateValue {
+	sort.Strings(keys)
+
+	return StandingOrderDueStateValue{
+		BaseHinter: hint.NewBaseHinter(StandingOrderDueStateValueHint),
+		Keys:       keys,
+	}
+}
+
+func (s StandingOrderDueStateValue) Hint() hint.Hint {
+	return s.BaseHinter.Hint()
+}
+
+func (s StandingOrderDueStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid StandingOrderDueStateValue")
+
+	if err := s.BaseHinter.IsValid(StandingOrderDueStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for i := range s.Keys {
+		if !IsStateStandingOrderKey(s.Keys[i]) {
+			return e.Wrap(errors.Errorf("invalid standing order key, %q", s.Keys[i]))
+		}
+
+		if i > 0 && strings.Compare(s.Keys[i-1], s.Keys[i]) >= 0 {
+			return e.Wrap(errors.Errorf("unsorted or duplicated standing order key, %q", s.Keys[i]))
+		}
+	}
+
+	return nil
+}
+
+func (s StandingOrderDueStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(s.Keys))
+	for i := range s.Keys {
+		bs[i] = []byte(s.Keys[i])
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateStandingOrderDueValue(st base.State) (StandingOrderDueStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return StandingOrderDueStateValue{}, util.ErrNotFound.Errorf("standing order due not found in State")
+	}
+
+	s, ok := v.(StandingOrderDueStateValue)
+	if !ok {
+		return StandingOrderDueStateValue{}, errors.Errorf("invalid standing order due value found, %T", v)
+	}
+
+	return s, nil
+}
+
+// NewStandingOrderDueMergeValue returns the merge value, which adds the
+// orders of adds to and removes the orders of removes from the due orders at
+// height. The changes of the same block are merged by
+// StandingOrderDueStateValueMerger.
+func NewStandingOrderDueMergeValue(height base.Height, adds, removes []string) base.StateMergeValue {
+	key := StateKeyStandingOrderDue(height)
+
+	return common.NewBaseStateMergeValue(
+		key,
+		standingOrderDueUpdateValue{adds: adds, removes: removes},
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewStandingOrderDueStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type StandingOrderDueStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existings []string
+	updates   []standingOrderDueUpdateValue
+}
+
+func NewStandingOrderDueStateValueMerger(
+	height base.Height, key string, st base.State,
+) *StandingOrderDueStateValueMerger {
+	s := &StandingOrderDueStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, key, st),
+	}
+
+	if st != nil {
+		if v, ok := st.Value().(StandingOrderDueStateValue); ok {
+			s.existings = v.Keys
+		}
+	}
+
+	return s
+}
+
+func (s *StandingOrderDueStateValueMerger) Merge(value base.StateValue, ops []util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	u, ok := value.(standingOrderDueUpdateValue)
+	if !ok {
+		return errors.Errorf("expected standing order due update, not %T", value)
+	}
+
+	s.updates = append(s.updates, u)
+
+	s.AddOperations(ops)
+
+	return nil
+}
+
+func (s *StandingOrderDueStateValueMerger) Close() error {
+	s.BaseStateValueMerger.SetValue(s.close())
+
+	return s.BaseStateValueMerger.Close()
+}
+
+func (s *StandingOrderDueStateValueMerger) close() StandingOrderDueStateValue {
+	s.Lock()
+	defer s.Unlock()
+
+	m := map[string]struct{}{}
+	for i := range s.existings {
+		m[s.existings[i]] = struct{}{}
+	}
+
+	// NOTE the payment removes the orders only from the heights, which are not
+	// over the block height, and the registration adds the order only to the
+	// height over the block height, so the removes and the adds of the same
+	// block do not conflict each other.
+	for i := range s.updates {
+		for j := range s.updates[i].removes {
+			delete(m, s.updates[i].removes[j])
+		}
+	}
+
+	for i := range s.updates {
+		for j := range s.updates[i].adds {
+			m[s.updates[i].adds[j]] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return NewStandingOrderDueStateValue(keys)
+}
+
+type standingOrderDueUpdateValue struct {
+	adds    []string
+	removes []string
+}
+
+func (standingOrderDueUpdateValue) HashBytes() []byte {
+	return nil
+}
+
+func (standingOrderDueUpdateValue) IsValid([]byte) error {
+	return nil
+}
+
+// StandingOrderCursorStateValue keeps the last height, which the due standing
+// orders are paid until.
+type StandingOrderCursorStateValue struct {
+	hint.BaseHinter
+	Height base.Height
+}
+
+func NewStandingOrderCursorStateValue(height base.Height) StandingOrderCursorStateValue {
+	return StandingOrderCursorStateValue{
+		BaseHinter: hint.NewBaseHinter(StandingOrderCursorStateValueHint),
+		Height:     height,
+	}
+}
+
+func (s StandingOrderCursorStateValue) Hint() hint.Hint {
+	return s.BaseHinter.Hint()
+}
+
+func (s StandingOrderCursorStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid StandingOrderCursorStateValue")
+
+	if err := s.BaseHinter.IsValid(StandingOrderCursorStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := s.Height.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (s StandingOrderCursorStateValue) HashBytes() []byte {
+	return s.Height.Bytes()
+}
+
+func StateStandingOrderCursorValue(st base.State) (StandingOrderCursorStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return StandingOrderCursorStateValue{}, util.ErrNotFound.Errorf("standing order cursor not found in State")
+	}
+
+	s, ok := v.(StandingOrderCursorStateValue)
+	if !ok {
+		return StandingOrderCursorStateValue{}, errors.Errorf("invalid standing order cursor value found, %T", v)
+	}
+
+	return s, nil
+}
+
+// NewStandingOrderCursorMergeValue returns the merge value of the cursor; the
+// highest height of the same block is kept.
+func NewStandingOrderCursorMergeValue(height base.Height) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(
+		StateKeyStandingOrderCursor,
+		NewStandingOrderCursorStateValue(height),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewStandingOrderCursorStateValueMerger(height, st)
+		},
+	)
+}
+
+type StandingOrderCursorStateValueMerger struct {
+	*common.BaseStateValueMerger
+	height base.Height
+}
+
+func NewStandingOrderCursorStateValueMerger(
+	height base.Height, st base.State,
+) *StandingOrderCursorStateValueMerger {
+	s := &StandingOrderCursorStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, StateKeyStandingOrderCursor, st),
+		height:               base.NilHeight,
+	}
+
+	if st != nil {
+		if v, ok := st.Value().(StandingOrderCursorStateValue); ok {
+			s.height = v.Height
+		}
+	}
+
+	return s
+}
+
+func (s *StandingOrderCursorStateValueMerger) Merge(value base.StateValue, ops []util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	v, ok := value.(StandingOrderCursorStateValue)
+	if !ok {
+		return errors.Errorf("expected StandingOrderCursorStateValue, not %T", value)
+	}
+
+	if v.Height > s.height {
+		s.height = v.Height
+	}
+
+	s.AddOperations(ops)
+
+	return nil
+}
+
+func (s *StandingOrderCursorStateValueMerger) Close() error {
+	s.Lock()
+	height := s.height
+	s.Unlock()
+
+	s.BaseStateValueMerger.SetValue(NewStandingOrderCursorStateValue(height))
+
+	return s.BaseStateValueMerger.Close()
+}
+
+// NewStandingOrderMergeValue returns the merge value, which sets the standing
+// order of key.
+func NewStandingOrderMergeValue(key string, v StandingOrderStateValue) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(
+		key,
+		v,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewStandingOrderStateValueMerger(height, key, st)
+		},
+	)
+}
+
+// NewStandingOrderCancelMergeValue returns the merge value, which cancels the
+// standing order of key.
+func NewStandingOrderCancelMergeValue(key string) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(
+		key,
+		standingOrderCancelValue{},
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewStandingOrderStateValueMerger(height, key, st)
+		},
+	)
+}
+
+// StandingOrderStateValueMerger merges the payment and the cancel of the same
+// standing order in the same block; the cancel is kept whatever the order of
+// operations is.
+type StandingOrderStateValueMerger struct {
+	*common.BaseStateValueMerger
+	value     *StandingOrderStateValue
+	cancelled bool
+}
+
+func NewStandingOrderStateValueMerger(
+	height base.Height, key string, st base.State,
+) *StandingOrderStateValueMerger {
+	s := &StandingOrderStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, key, st),
+	}
+
+	if st != nil {
+		if v, ok := st.Value().(StandingOrderStateValue); ok {
+			s.value = &v
+		}
+	}
+
+	return s
+}
+
+func (s *StandingOrderStateValueMerger) Merge(value base.StateValue, ops []util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch v := value.(type) {
+	case StandingOrderStateValue:
+		s.value = &v
+	case standingOrderCancelValue:
+		s.cancelled = true
+	default:
+		return errors.Errorf("expected StandingOrderStateValue, not %T", value)
+	}
+
+	s.AddOperations(ops)
+
+	return nil
+}
+
+func (s *StandingOrderStateValueMerger) Close() error {
+	s.Lock()
+	value := s.value
+	cancelled := s.cancelled
+	s.Unlock()
+
+	if value == nil {
+		return errors.Errorf("empty standing order")
+	}
+
+	v := *value
+	if cancelled {
+		v = v.Cancel()
+	}
+
+	s.BaseStateValueMerger.SetValue(v)
+
+	return s.BaseStateValueMerger.Close()
+}
+
+type standingOrderCancelValue struct{}
+
+func (standingOrderCancelValue) HashBytes() []byte {
+	return nil
+}
+
+func (standingOrderCancelValue) IsValid([]byte) error {
+	return nil
+}
+
 func StateBalanceKeyPrefix(a base.Address, cid types.CurrencyID) string {
 	return fmt.Sprintf("%s-%s", a.String(), cid)
 }
@@ -201,3 +1016,53 @@ func IsStateCurrencyDesignKey(key string) bool {
 func StateKeyCurrencyDesign(cid types.CurrencyID) string {
 	return fmt.Sprintf("%s%s", StateKeyCurrencyDesignPrefix, cid)
 }
+
+func IsStateFeeRateKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyFeeRatePrefix)
+}
+
+func StateKeyFeeRate(cid, feeCurrency types.CurrencyID) string {
+	return fmt.Sprintf("%s%s:%s", StateKeyFeeRatePrefix, cid, feeCurrency)
+}
+
+func IsStateFrozenKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyFrozenSuffix)
+}
+
+func StateKeyFrozen(a base.Address, cid types.CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateBalanceKeyPrefix(a, cid), StateKeyFrozenSuffix)
+}
+
+func IsStateVestingKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyVestingSuffix)
+}
+
+// StateKeyVesting returns the key of the vesting from sender to receiver,
+// "<receiver>-<currency>-<sender>:vesting".
+func StateKeyVesting(receiver, sender base.Address, cid types.CurrencyID) string {
+	return fmt.Sprintf("%s-%s%s", StateBalanceKeyPrefix(receiver, cid), sender.String(), StateKeyVestingSuffix)
+}
+
+func IsStateAllowanceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAllowanceSuffix)
+}
+
+func StateKeyAllowance(owner, spender base.Address, cid types.CurrencyID) string {
+	return fmt.Sprintf("%s-%s%s", StateBalanceKeyPrefix(owner, cid), spender.String(), StateKeyAllowanceSuffix)
+}
+
+func IsStateStandingOrderKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyStandingOrderSuffix)
+}
+
+func StateKeyStandingOrder(sender base.Address, id types.StandingOrderID) string {
+	return fmt.Sprintf("%s-%s%s", sender.String(), id, StateKeyStandingOrderSuffix)
+}
+
+func IsStateStandingOrderDueKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyStandingOrderDuePrefix)
+}
+
+func StateKeyStandingOrderDue(height base.Height) string {
+	return fmt.Sprintf("%s%d", StateKeyStandingOrderDuePrefix, height)
+}
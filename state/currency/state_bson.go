@@ -1,8 +1,10 @@
 package currency
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/bson"
@@ -122,3 +124,340 @@ func (c *CurrencyDesignStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) er
 
 	return nil
 }
+
+func (f FeeRateStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        f.Hint().String(),
+			"currency":     f.Currency,
+			"fee_currency": f.FeeCurrency,
+			"rate":         f.Rate.String(),
+		},
+	)
+}
+
+type FeeRateStateValueBSONUnmarshaler struct {
+	Hint        string `bson:"_hint"`
+	Currency    string `bson:"currency"`
+	FeeCurrency string `bson:"fee_currency"`
+	Rate        string `bson:"rate"`
+}
+
+func (f *FeeRateStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode FeeRateStateValue")
+
+	var u FeeRateStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	f.BaseHinter = hint.NewBaseHinter(ht)
+
+	f.Currency = types.CurrencyID(u.Currency)
+	f.FeeCurrency = types.CurrencyID(u.FeeCurrency)
+
+	rate, err := types.ParseFeeRate(u.Rate)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	f.Rate = rate
+
+	return nil
+}
+
+func (f FrozenStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    f.Hint().String(),
+			"currency": f.Currency,
+			"frozen":   f.Frozen,
+		},
+	)
+}
+
+type FrozenStateValueBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Currency string `bson:"currency"`
+	Frozen   bool   `bson:"frozen"`
+}
+
+func (f *FrozenStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode FrozenStateValue")
+
+	var u FrozenStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	f.BaseHinter = hint.NewBaseHinter(ht)
+
+	f.Currency = types.CurrencyID(u.Currency)
+	f.Frozen = u.Frozen
+
+	return nil
+}
+
+func (v VestingStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    v.Hint().String(),
+			"sender":   v.Sender,
+			"receiver": v.Receiver,
+			"amount":   v.Amount,
+			"claimed":  v.Claimed.String(),
+			"start":    v.Start,
+			"cliff":    v.Cliff,
+			"end":      v.End,
+		},
+	)
+}
+
+type VestingStateValueBSONUnmarshaler struct {
+	Hint     string      `bson:"_hint"`
+	Sender   string      `bson:"sender"`
+	Receiver string      `bson:"receiver"`
+	Amount   bson.Raw    `bson:"amount"`
+	Claimed  string      `bson:"claimed"`
+	Start    base.Height `bson:"start"`
+	Cliff    base.Height `bson:"cliff"`
+	End      base.Height `bson:"end"`
+}
+
+func (v *VestingStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode VestingStateValue")
+
+	var u VestingStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.BaseHinter = hint.NewBaseHinter(ht)
+
+	sender, err := base.DecodeAddress(u.Sender, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.Sender = sender
+
+	receiver, err := base.DecodeAddress(u.Receiver, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.Receiver = receiver
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	v.Amount = am
+
+	claimed, err := common.NewBigFromString(u.Claimed)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.Claimed = claimed
+
+	v.Start = u.Start
+	v.Cliff = u.Cliff
+	v.End = u.End
+
+	return nil
+}
+
+func (a AllowanceStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   a.Hint().String(),
+			"owner":   a.Owner,
+			"spender": a.Spender,
+			"amount":  a.Amount,
+		},
+	)
+}
+
+type AllowanceStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Owner   string   `bson:"owner"`
+	Spender string   `bson:"spender"`
+	Amount  bson.Raw `bson:"amount"`
+}
+
+func (a *AllowanceStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode AllowanceStateValue")
+
+	var u AllowanceStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	a.BaseHinter = hint.NewBaseHinter(ht)
+
+	owner, err := base.DecodeAddress(u.Owner, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	a.Owner = owner
+
+	spender, err := base.DecodeAddress(u.Spender, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	a.Spender = spender
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	a.Amount = am
+
+	return nil
+}
+
+func (s StandingOrderStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     s.Hint().String(),
+			"sender":    s.Sender,
+			"receiver":  s.Receiver,
+			"amount":    s.Amount,
+			"start":     s.Start,
+			"interval":  s.Interval,
+			"count":     s.Count,
+			"paid":      s.Paid,
+			"cancelled": s.Cancelled,
+		},
+	)
+}
+
+type StandingOrderStateValueBSONUnmarshaler struct {
+	Hint      string      `bson:"_hint"`
+	Sender    string      `bson:"sender"`
+	Receiver  string      `bson:"receiver"`
+	Amount    bson.Raw    `bson:"amount"`
+	Start     base.Height `bson:"start"`
+	Interval  uint64      `bson:"interval"`
+	Count     uint64      `bson:"count"`
+	Paid      uint64      `bson:"paid"`
+	Cancelled bool        `bson:"cancelled"`
+}
+
+func (s *StandingOrderStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode StandingOrderStateValue")
+
+	var u StandingOrderStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	sender, err := base.DecodeAddress(u.Sender, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.Sender = sender
+
+	receiver, err := base.DecodeAddress(u.Receiver, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.Receiver = receiver
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Amount = am
+
+	s.Start = u.Start
+	s.Interval = u.Interval
+	s.Count = u.Count
+	s.Paid = u.Paid
+	s.Cancelled = u.Cancelled
+
+	return nil
+}
+
+func (s StandingOrderDueStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"keys":  s.Keys,
+		},
+	)
+}
+
+type StandingOrderDueStateValueBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Keys []string `bson:"keys"`
+}
+
+func (s *StandingOrderDueStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode StandingOrderDueStateValue")
+
+	var u StandingOrderDueStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Keys = u.Keys
+
+	return nil
+}
+
+func (s StandingOrderCursorStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"height": s.Height,
+		},
+	)
+}
+
+type StandingOrderCursorStateValueBSONUnmarshaler struct {
+	Hint   string      `bson:"_hint"`
+	Height base.Height `bson:"height"`
+}
+
+func (s *StandingOrderCursorStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode StandingOrderCursorStateValue")
+
+	var u StandingOrderCursorStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Height = u.Height
+
+	return nil
+}
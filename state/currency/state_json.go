@@ -2,7 +2,9 @@ package currency
 
 import (
 	"encoding/json"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -112,3 +114,349 @@ func (c *CurrencyDesignStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) er
 
 	return nil
 }
+
+type FeeRateStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Currency    types.CurrencyID `json:"currency"`
+	FeeCurrency types.CurrencyID `json:"fee_currency"`
+	Rate        types.FeeRate    `json:"rate"`
+}
+
+func (f FeeRateStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeRateStateValueJSONMarshaler{
+		BaseHinter:  f.BaseHinter,
+		Currency:    f.Currency,
+		FeeCurrency: f.FeeCurrency,
+		Rate:        f.Rate,
+	})
+}
+
+type FeeRateStateValueJSONUnmarshaler struct {
+	Hint        hint.Hint `json:"_hint"`
+	Currency    string    `json:"currency"`
+	FeeCurrency string    `json:"fee_currency"`
+	Rate        string    `json:"rate"`
+}
+
+func (f *FeeRateStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode FeeRateStateValue")
+
+	var u FeeRateStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	f.BaseHinter = hint.NewBaseHinter(u.Hint)
+	f.Currency = types.CurrencyID(u.Currency)
+	f.FeeCurrency = types.CurrencyID(u.FeeCurrency)
+
+	rate, err := types.ParseFeeRate(u.Rate)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	f.Rate = rate
+
+	return nil
+}
+
+type FrozenStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Currency types.CurrencyID `json:"currency"`
+	Frozen   bool             `json:"frozen"`
+}
+
+func (f FrozenStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FrozenStateValueJSONMarshaler{
+		BaseHinter: f.BaseHinter,
+		Currency:   f.Currency,
+		Frozen:     f.Frozen,
+	})
+}
+
+type FrozenStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Currency string    `json:"currency"`
+	Frozen   bool      `json:"frozen"`
+}
+
+func (f *FrozenStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode FrozenStateValue")
+
+	var u FrozenStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	f.BaseHinter = hint.NewBaseHinter(u.Hint)
+	f.Currency = types.CurrencyID(u.Currency)
+	f.Frozen = u.Frozen
+
+	return nil
+}
+
+type VestingStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Sender   base.Address `json:"sender"`
+	Receiver base.Address `json:"receiver"`
+	Amount   types.Amount `json:"amount"`
+	Claimed  string       `json:"claimed"`
+	Start    base.Height  `json:"start"`
+	Cliff    base.Height  `json:"cliff"`
+	End      base.Height  `json:"end"`
+}
+
+func (v VestingStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VestingStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Sender:     v.Sender,
+		Receiver:   v.Receiver,
+		Amount:     v.Amount,
+		Claimed:    v.Claimed.String(),
+		Start:      v.Start,
+		Cliff:      v.Cliff,
+		End:        v.End,
+	})
+}
+
+type VestingStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Sender   string          `json:"sender"`
+	Receiver string          `json:"receiver"`
+	Amount   json.RawMessage `json:"amount"`
+	Claimed  string          `json:"claimed"`
+	Start    base.Height     `json:"start"`
+	Cliff    base.Height     `json:"cliff"`
+	End      base.Height     `json:"end"`
+}
+
+func (v *VestingStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode VestingStateValue")
+
+	var u VestingStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	sender, err := base.DecodeAddress(u.Sender, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.Sender = sender
+
+	receiver, err := base.DecodeAddress(u.Receiver, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.Receiver = receiver
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	v.Amount = am
+
+	claimed, err := common.NewBigFromString(u.Claimed)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.Claimed = claimed
+
+	v.Start = u.Start
+	v.Cliff = u.Cliff
+	v.End = u.End
+
+	return nil
+}
+
+type AllowanceStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Owner   base.Address `json:"owner"`
+	Spender base.Address `json:"spender"`
+	Amount  types.Amount `json:"amount"`
+}
+
+func (a AllowanceStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AllowanceStateValueJSONMarshaler{
+		BaseHinter: a.BaseHinter,
+		Owner:      a.Owner,
+		Spender:    a.Spender,
+		Amount:     a.Amount,
+	})
+}
+
+type AllowanceStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Owner   string          `json:"owner"`
+	Spender string          `json:"spender"`
+	Amount  json.RawMessage `json:"amount"`
+}
+
+func (a *AllowanceStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode AllowanceStateValue")
+
+	var u AllowanceStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	a.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	owner, err := base.DecodeAddress(u.Owner, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	a.Owner = owner
+
+	spender, err := base.DecodeAddress(u.Spender, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	a.Spender = spender
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	a.Amount = am
+
+	return nil
+}
+
+type StandingOrderStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Sender    base.Address `json:"sender"`
+	Receiver  base.Address `json:"receiver"`
+	Amount    types.Amount `json:"amount"`
+	Start     base.Height  `json:"start"`
+	Interval  uint64       `json:"interval"`
+	Count     uint64       `json:"count"`
+	Paid      uint64       `json:"paid"`
+	Cancelled bool         `json:"cancelled"`
+}
+
+func (s StandingOrderStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StandingOrderStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Sender:     s.Sender,
+		Receiver:   s.Receiver,
+		Amount:     s.Amount,
+		Start:      s.Start,
+		Interval:   s.Interval,
+		Count:      s.Count,
+		Paid:       s.Paid,
+		Cancelled:  s.Cancelled,
+	})
+}
+
+type StandingOrderStateValueJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Sender    string          `json:"sender"`
+	Receiver  string          `json:"receiver"`
+	Amount    json.RawMessage `json:"amount"`
+	Start     base.Height     `json:"start"`
+	Interval  uint64          `json:"interval"`
+	Count     uint64          `json:"count"`
+	Paid      uint64          `json:"paid"`
+	Cancelled bool            `json:"cancelled"`
+}
+
+func (s *StandingOrderStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode StandingOrderStateValue")
+
+	var u StandingOrderStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	sender, err := base.DecodeAddress(u.Sender, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.Sender = sender
+
+	receiver, err := base.DecodeAddress(u.Receiver, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.Receiver = receiver
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Amount = am
+
+	s.Start = u.Start
+	s.Interval = u.Interval
+	s.Count = u.Count
+	s.Paid = u.Paid
+	s.Cancelled = u.Cancelled
+
+	return nil
+}
+
+type StandingOrderDueStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Keys []string `json:"keys"`
+}
+
+func (s StandingOrderDueStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StandingOrderDueStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Keys:       s.Keys,
+	})
+}
+
+type StandingOrderDueStateValueJSONUnmarshaler struct {
+	Hint hint.Hint `json:"_hint"`
+	Keys []string  `json:"keys"`
+}
+
+func (s *StandingOrderDueStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode StandingOrderDueStateValue")
+
+	var u StandingOrderDueStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Keys = u.Keys
+
+	return nil
+}
+
+type StandingOrderCursorStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Height base.Height `json:"height"`
+}
+
+func (s StandingOrderCursorStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StandingOrderCursorStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Height:     s.Height,
+	})
+}
+
+type StandingOrderCursorStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint   `json:"_hint"`
+	Height base.Height `json:"height"`
+}
+
+func (s *StandingOrderCursorStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode StandingOrderCursorStateValue")
+
+	var u StandingOrderCursorStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Height = u.Height
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package escrow
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var EscrowStateValueHint = hint.MustNewHint("escrow-state-value-v0.0.1")
+
+var StateKeyEscrowPrefix = "escrow:"
+
+type EscrowStatus string
+
+const (
+	EscrowStatusOpen     = EscrowStatus("open")
+	EscrowStatusClaimed  = EscrowStatus("claimed")
+	EscrowStatusRefunded = EscrowStatus("refunded")
+)
+
+func (s EscrowStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s EscrowStatus) String() string {
+	return string(s)
+}
+
+func (s EscrowStatus) IsValid([]byte) error {
+	switch s {
+	case EscrowStatusOpen, EscrowStatusClaimed, EscrowStatusRefunded:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown escrow status, %q", s)
+	}
+}
+
+// EscrowStateValue keeps the Amount debited from Sender. Receiver gets the
+// Amount by revealing the preimage of Hashlock until Timeout height, and after
+// Timeout Sender can get it back. The settled escrow remains with the revealed
+// Preimage, so the counterparty of atomic swap can read it.
+type EscrowStateValue struct {
+	hint.BaseHinter
+	Sender   base.Address
+	Receiver base.Address
+	Amount   types.Amount
+	Hashlock []byte
+	Timeout  base.Height
+	Status   EscrowStatus
+	Preimage []byte
+}
+
+func NewEscrowStateValue(
+	sender, receiver base.Address,
+	amount types.Amount,
+	hashlock []byte,
+	timeout base.Height,
+	status EscrowStatus,
+	preimage []byte,
+) EscrowStateValue {
+	return EscrowStateValue{
+		BaseHinter: hint.NewBaseHinter(EscrowStateValueHint),
+		Sender:     sender,
+		Receiver:   receiver,
+		Amount:     amount,
+		Hashlock:   hashlock,
+		Timeout:    timeout,
+		Status:     status,
+		Preimage:   preimage,
+	}
+}
+
+func (v EscrowStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v EscrowStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid EscrowStateValue")
+
+	if err := v.BaseHinter.IsValid(EscrowStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Sender, v.Receiver, v.Amount, v.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := types.IsValidEscrowHashlock(v.Hashlock); err != nil {
+		return e.Wrap(err)
+	}
+
+	if v.Timeout <= base.GenesisHeight {
+		return e.Wrap(errors.Errorf("invalid timeout height, %v", v.Timeout))
+	}
+
+	switch {
+	case v.Status == EscrowStatusClaimed:
+		if !bytes.Equal(types.EscrowHashlock(v.Preimage), v.Hashlock) {
+			return e.Wrap(errors.Errorf("preimage does not match with hashlock"))
+		}
+	case len(v.Preimage) > 0:
+		return e.Wrap(errors.Errorf("preimage found in %v escrow", v.Status))
+	}
+
+	return nil
+}
+
+func (v EscrowStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Sender.Bytes(),
+		v.Receiver.Bytes(),
+		v.Amount.Bytes(),
+		v.Hashlock,
+		v.Timeout.Bytes(),
+		v.Status.Bytes(),
+		v.Preimage,
+	)
+}
+
+func StateKeyEscrow(id types.EscrowID) string {
+	return fmt.Sprintf("%s%s", StateKeyEscrowPrefix, id)
+}
+
+func IsStateEscrowKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyEscrowPrefix)
+}
+
+func StateEscrowValue(st base.State) (EscrowStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return EscrowStateValue{}, util.ErrNotFound.Errorf("escrow not found in State")
+	}
+
+	es, ok := v.(EscrowStateValue)
+	if !ok {
+		return EscrowStateValue{}, errors.Errorf("invalid escrow value found, %T", v)
+	}
+
+	return es, nil
+}
@@ -0,0 +1,62 @@
+package escrow
+
+import (
+	"encoding/hex"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (v EscrowStateValue) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":    v.Hint().String(),
+		"sender":   v.Sender,
+		"receiver": v.Receiver,
+		"amount":   v.Amount,
+		"hashlock": hex.EncodeToString(v.Hashlock),
+		"timeout":  v.Timeout,
+		"status":   v.Status.String(),
+	}
+
+	if len(v.Preimage) > 0 {
+		m["preimage"] = hex.EncodeToString(v.Preimage)
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type EscrowStateValueBSONUnmarshaler struct {
+	Hint     string      `bson:"_hint"`
+	Sender   string      `bson:"sender"`
+	Receiver string      `bson:"receiver"`
+	Amount   bson.Raw    `bson:"amount"`
+	Hashlock string      `bson:"hashlock"`
+	Timeout  base.Height `bson:"timeout"`
+	Status   string      `bson:"status"`
+	Preimage string      `bson:"preimage,omitempty"`
+}
+
+func (v *EscrowStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of EscrowStateValue")
+
+	var u EscrowStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	return v.unpack(enc, ht, u.Sender, u.Receiver, am, u.Hashlock, u.Timeout, u.Status, u.Preimage)
+}
@@ -0,0 +1,60 @@
+package escrow
+
+import (
+	"encoding/hex"
+
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (v *EscrowStateValue) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	sd, rc string,
+	am types.Amount,
+	hl string,
+	timeout base.Height,
+	status, pi string,
+) error {
+	e := util.StringError("unmarshal EscrowStateValue")
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		v.Sender = a
+	}
+
+	switch a, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		v.Receiver = a
+	}
+
+	v.Amount = am
+
+	hashlock, err := hex.DecodeString(hl)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.Hashlock = hashlock
+
+	v.Timeout = timeout
+	v.Status = EscrowStatus(status)
+
+	if len(pi) > 0 {
+		preimage, err := hex.DecodeString(pi)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		v.Preimage = preimage
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package escrow
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type EscrowStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Sender   base.Address `json:"sender"`
+	Receiver base.Address `json:"receiver"`
+	Amount   types.Amount `json:"amount"`
+	Hashlock string       `json:"hashlock"`
+	Timeout  base.Height  `json:"timeout"`
+	Status   EscrowStatus `json:"status"`
+	Preimage string       `json:"preimage,omitempty"`
+}
+
+func (v EscrowStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(EscrowStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Sender:     v.Sender,
+		Receiver:   v.Receiver,
+		Amount:     v.Amount,
+		Hashlock:   hex.EncodeToString(v.Hashlock),
+		Timeout:    v.Timeout,
+		Status:     v.Status,
+		Preimage:   hex.EncodeToString(v.Preimage),
+	})
+}
+
+type EscrowStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Sender   string          `json:"sender"`
+	Receiver string          `json:"receiver"`
+	Amount   json.RawMessage `json:"amount"`
+	Hashlock string          `json:"hashlock"`
+	Timeout  base.Height     `json:"timeout"`
+	Status   string          `json:"status"`
+	Preimage string          `json:"preimage"`
+}
+
+func (v *EscrowStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode json of EscrowStateValue")
+
+	var u EscrowStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	return v.unpack(enc, u.Hint, u.Sender, u.Receiver, am, u.Hashlock, u.Timeout, u.Status, u.Preimage)
+}
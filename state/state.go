@@ -109,6 +109,49 @@ func ExistsCurrencyPolicy(cid types.CurrencyID, getStateFunc base.GetStateFunc)
 	return policy, nil
 }
 
+func ExistsFeeRate(cid, feeCurrency types.CurrencyID, getStateFunc base.GetStateFunc) (types.FeeRate, error) {
+	switch i, found, err := getStateFunc(currency.StateKeyFeeRate(cid, feeCurrency)); {
+	case err != nil:
+		return types.FeeRate{}, err
+	case !found:
+		return types.FeeRate{}, base.NewBaseOperationProcessReasonError("fee rate not found, %v to %v", cid, feeCurrency)
+	default:
+		fr, ok := i.Value().(currency.FeeRateStateValue) //nolint:forcetypeassert //...
+		if !ok {
+			return types.FeeRate{}, errors.Errorf("expected FeeRateStateValue, not %T", i.Value())
+		}
+
+		if fr.Rate.IsZero() {
+			return types.FeeRate{}, base.NewBaseOperationProcessReasonError(
+				"fee currency not accepted, %v to %v", cid, feeCurrency)
+		}
+
+		return fr.Rate, nil
+	}
+}
+
+// CheckNotFrozen checks that the balance of currency of the address is not
+// frozen.
+func CheckNotFrozen(a base.Address, cid types.CurrencyID, getStateFunc base.GetStateFunc) error {
+	switch i, found, err := getStateFunc(currency.StateKeyFrozen(a, cid)); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		fr, ok := i.Value().(currency.FrozenStateValue) //nolint:forcetypeassert //...
+		if !ok {
+			return errors.Errorf("expected FrozenStateValue, not %T", i.Value())
+		}
+
+		if fr.Frozen {
+			return base.NewBaseOperationProcessReasonError("balance frozen, %v of %v", cid, a)
+		}
+
+		return nil
+	}
+}
+
 func CheckFactSignsByState(
 	address base.Address,
 	fs []base.Sign,
@@ -132,3 +175,62 @@ func CheckFactSignsByState(
 
 	return nil
 }
+
+// CheckFactSignsWithFeePayer checks the signs of address like
+// CheckFactSignsByState. If feePayer is not nil, the signs should also pass the
+// threshold of feePayer, and each sign should belong to the keys of address or
+// feePayer.
+func CheckFactSignsWithFeePayer(
+	address, feePayer base.Address,
+	fs []base.Sign,
+	getState base.GetStateFunc,
+) error {
+	if feePayer == nil {
+		return CheckFactSignsByState(address, fs, getState)
+	}
+
+	var keys [2]types.AccountKeys
+	for i, a := range []base.Address{address, feePayer} {
+		st, err := ExistsState(currency.StateKeyAccount(a), "keys of account", getState)
+		if err != nil {
+			return err
+		}
+
+		switch ks, err := currency.StateKeysValue(st); {
+		case err != nil:
+			return base.NewBaseOperationProcessReasonError("failed to get Keys; %w", err)
+		case ks == nil:
+			return base.NewBaseOperationProcessReasonError("empty keys found, %v", a)
+		default:
+			keys[i] = ks
+		}
+	}
+
+	var afs, ffs []base.Sign
+	for i := range fs {
+		_, inAddress := keys[0].Key(fs[i].Signer())
+		_, inFeePayer := keys[1].Key(fs[i].Signer())
+
+		if !inAddress && !inFeePayer {
+			return base.NewBaseOperationProcessReasonError("unknown key found, %s", fs[i].Signer())
+		}
+
+		if inAddress {
+			afs = append(afs, fs[i])
+		}
+
+		if inFeePayer {
+			ffs = append(ffs, fs[i])
+		}
+	}
+
+	if err := types.CheckThreshold(afs, keys[0]); err != nil {
+		return base.NewBaseOperationProcessReasonError("failed to check threshold; %w", err)
+	}
+
+	if err := types.CheckThreshold(ffs, keys[1]); err != nil {
+		return base.NewBaseOperationProcessReasonError("failed to check threshold of fee payer; %w", err)
+	}
+
+	return nil
+}
@@ -33,7 +33,15 @@ func (cs ContractAccountStatus) Bytes() []byte {
 		v = 1
 	}
 
-	return util.ConcatBytesSlice(cs.owner.Bytes(), []byte{byte(v)})
+	bs := make([][]byte, len(cs.operators)+2)
+	bs[0] = cs.owner.Bytes()
+	bs[1] = []byte{byte(v)}
+
+	for i := range cs.operators {
+		bs[i+2] = cs.operators[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
 }
 
 func (cs ContractAccountStatus) Hash() util.Hash {
@@ -45,6 +53,19 @@ func (cs ContractAccountStatus) GenerateHash() util.Hash {
 }
 
 func (cs ContractAccountStatus) IsValid([]byte) error { // nolint:revive
+	founds := map[string]struct{}{}
+	for i := range cs.operators {
+		op := cs.operators[i]
+		if err := util.CheckIsValiders(nil, false, op); err != nil {
+			return err
+		}
+
+		if _, found := founds[op.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate operator found, %v", op)
+		}
+		founds[op.String()] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -72,6 +93,32 @@ func (cs ContractAccountStatus) SetIsActive(b bool) ContractAccountStatus { // n
 	return cs
 }
 
+func (cs ContractAccountStatus) Operators() []base.Address { // nolint:revive
+	return cs.operators
+}
+
+func (cs ContractAccountStatus) SetOperators(operators []base.Address) (ContractAccountStatus, error) { // nolint:revive
+	for i := range operators {
+		if err := operators[i].IsValid(nil); err != nil {
+			return ContractAccountStatus{}, err
+		}
+	}
+
+	cs.operators = operators
+
+	return cs, nil
+}
+
+func (cs ContractAccountStatus) IsOperator(a base.Address) bool { // nolint:revive
+	for i := range cs.operators {
+		if cs.operators[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (cs ContractAccountStatus) Equal(b ContractAccountStatus) bool {
 	if cs.isActive != b.isActive {
 		return false
@@ -79,6 +126,14 @@ func (cs ContractAccountStatus) Equal(b ContractAccountStatus) bool {
 	if !cs.owner.Equal(b.owner) {
 		return false
 	}
+	if len(cs.operators) != len(b.operators) {
+		return false
+	}
+	for i := range cs.operators {
+		if !cs.operators[i].Equal(b.operators[i]) {
+			return false
+		}
+	}
 
 	return true
 }
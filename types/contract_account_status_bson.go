@@ -13,14 +13,16 @@ func (cs ContractAccountStatus) MarshalBSON() ([]byte, error) {
 			"_hint":     cs.Hint().String(),
 			"is_active": cs.isActive,
 			"owner":     cs.owner,
+			"operators": cs.operators,
 		},
 	)
 }
 
 type ContractAccountBSONUnmarshaler struct {
-	Hint     string `bson:"_hint"`
-	IsActive bool   `bson:"is_active"`
-	Owner    string `bson:"owner"`
+	Hint      string   `bson:"_hint"`
+	IsActive  bool     `bson:"is_active"`
+	Owner     string   `bson:"owner"`
+	Operators []string `bson:"operators"`
 }
 
 func (cs *ContractAccountStatus) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -36,5 +38,5 @@ func (cs *ContractAccountStatus) DecodeBSON(b []byte, enc *bsonenc.Encoder) erro
 		return e.Wrap(err)
 	}
 
-	return cs.unpack(enc, ht, ucs.IsActive, ucs.Owner)
+	return cs.unpack(enc, ht, ucs.IsActive, ucs.Owner, ucs.Operators)
 }
@@ -12,6 +12,7 @@ func (cs *ContractAccountStatus) unpack(
 	ht hint.Hint,
 	ia bool,
 	ow string,
+	ops []string,
 ) error {
 	e := util.StringError("unmarshal ContractAccountStatus")
 
@@ -24,6 +25,17 @@ func (cs *ContractAccountStatus) unpack(
 		cs.owner = a
 	}
 
+	operators := make([]base.Address, len(ops))
+	for i := range ops {
+		switch a, err := base.DecodeAddress(ops[i], enc); {
+		case err != nil:
+			return e.WithMessage(err, "failed to decode operator address")
+		default:
+			operators[i] = a
+		}
+	}
+	cs.operators = operators
+
 	cs.isActive = ia
 
 	return nil
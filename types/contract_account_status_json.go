@@ -9,8 +9,9 @@ import (
 
 type ContractAccountJSONMarshaler struct {
 	hint.BaseHinter
-	IsActive bool         `json:"is_active"`
-	Owner    base.Address `json:"owner"`
+	IsActive  bool           `json:"is_active"`
+	Owner     base.Address   `json:"owner"`
+	Operators []base.Address `json:"operators"`
 }
 
 func (cs ContractAccountStatus) MarshalJSON() ([]byte, error) {
@@ -18,13 +19,15 @@ func (cs ContractAccountStatus) MarshalJSON() ([]byte, error) {
 		BaseHinter: cs.BaseHinter,
 		IsActive:   cs.isActive,
 		Owner:      cs.owner,
+		Operators:  cs.operators,
 	})
 }
 
 type ContractAccountJSONUnmarshaler struct {
-	Hint     hint.Hint `json:"_hint"`
-	IsActive bool      `json:"is_active"`
-	Owner    string    `json:"owner"`
+	Hint      hint.Hint `json:"_hint"`
+	IsActive  bool      `json:"is_active"`
+	Owner     string    `json:"owner"`
+	Operators []string  `json:"operators"`
 }
 
 func (cs *ContractAccountStatus) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -35,5 +38,5 @@ func (cs *ContractAccountStatus) DecodeJSON(b []byte, enc *jsonenc.Encoder) erro
 		return e.Wrap(err)
 	}
 
-	return cs.unpack(enc, ucs.Hint, ucs.IsActive, ucs.Owner)
+	return cs.unpack(enc, ucs.Hint, ucs.IsActive, ucs.Owner, ucs.Operators)
 }
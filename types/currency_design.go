@@ -56,6 +56,10 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid CurrencyPolicy: %v", err)
 	}
 
+	if de.policy.IsOverMaxSupply(de.aggregate) {
+		return util.ErrInvalid.Errorf("aggregate over max supply, %v > %v", de.aggregate, de.policy.MaxSupply())
+	}
+
 	return nil
 }
 
@@ -110,3 +114,17 @@ func (de CurrencyDesign) AddAggregate(b common.Big) (CurrencyDesign, error) {
 
 	return de, nil
 }
+
+func (de CurrencyDesign) SubAggregate(b common.Big) (CurrencyDesign, error) {
+	if !b.OverZero() {
+		return de, errors.Errorf("removed aggregate not over zero")
+	}
+
+	if de.aggregate.Compare(b) < 0 {
+		return de, errors.Errorf("removed aggregate over aggregate, %v > %v", b, de.aggregate)
+	}
+
+	de.aggregate = de.aggregate.Sub(b)
+
+	return de, nil
+}
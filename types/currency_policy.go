@@ -14,6 +14,8 @@ type CurrencyPolicy struct {
 	hint.BaseHinter
 	newAccountMinBalance common.Big
 	feeer                Feeer
+	maxMemoSize          uint
+	maxSupply            common.Big
 }
 
 func NewCurrencyPolicy(newAccountMinBalance common.Big, feeer Feeer) CurrencyPolicy {
@@ -23,8 +25,21 @@ func NewCurrencyPolicy(newAccountMinBalance common.Big, feeer Feeer) CurrencyPol
 	}
 }
 
+// Bytes always has maxMemoSize and maxSupply; maxSupply is prefixed by it's
+// length, so the unset and zero values are not confused with the others.
 func (po CurrencyPolicy) Bytes() []byte {
-	return util.ConcatBytesSlice(po.newAccountMinBalance.Bytes(), po.feeer.Bytes())
+	var sb []byte
+	if po.maxSupply.Int != nil {
+		sb = po.maxSupply.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		po.newAccountMinBalance.Bytes(),
+		po.feeer.Bytes(),
+		util.UintToBytes(po.maxMemoSize),
+		util.UintToBytes(uint(len(sb))),
+		sb,
+	)
 }
 
 func (po CurrencyPolicy) IsValid([]byte) error {
@@ -36,6 +51,14 @@ func (po CurrencyPolicy) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid currency policy: %v", err)
 	}
 
+	if po.maxMemoSize > MemoSizeLimit {
+		return util.ErrInvalid.Errorf("max memo size over limit, %d > %d", po.maxMemoSize, MemoSizeLimit)
+	}
+
+	if po.maxSupply.Int != nil && !po.maxSupply.OverNil() {
+		return util.ErrInvalid.Errorf("max supply under zero")
+	}
+
 	return nil
 }
 
@@ -46,3 +69,45 @@ func (po CurrencyPolicy) NewAccountMinBalance() common.Big {
 func (po CurrencyPolicy) Feeer() Feeer {
 	return po.feeer
 }
+
+// MaxMemoSize returns the maximum memo size of currency; if not set,
+// MaxMemoSize is used.
+func (po CurrencyPolicy) MaxMemoSize() uint {
+	if po.maxMemoSize < 1 {
+		return MaxMemoSize
+	}
+
+	return po.maxMemoSize
+}
+
+func (po CurrencyPolicy) SetMaxMemoSize(n uint) CurrencyPolicy {
+	po.maxMemoSize = n
+
+	return po
+}
+
+// MaxSupply returns the hard cap of the aggregate of currency; zero means no
+// cap.
+func (po CurrencyPolicy) MaxSupply() common.Big {
+	if po.maxSupply.Int == nil {
+		return common.ZeroBig
+	}
+
+	return po.maxSupply
+}
+
+func (po CurrencyPolicy) SetMaxSupply(n common.Big) CurrencyPolicy {
+	po.maxSupply = n
+
+	return po
+}
+
+// IsOverMaxSupply checks whether the aggregate exceeds the max supply.
+func (po CurrencyPolicy) IsOverMaxSupply(aggregate common.Big) bool {
+	ms := po.MaxSupply()
+	if !ms.OverZero() {
+		return false
+	}
+
+	return aggregate.Compare(ms) > 0
+}
@@ -9,19 +9,26 @@ import (
 )
 
 func (po CurrencyPolicy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":                   po.Hint().String(),
-			"new_account_min_balance": po.newAccountMinBalance.String(),
-			"feeer":                   po.feeer,
-		},
-	)
+	m := bson.M{
+		"_hint":                   po.Hint().String(),
+		"new_account_min_balance": po.newAccountMinBalance.String(),
+		"feeer":                   po.feeer,
+		"max_memo_size":           po.maxMemoSize,
+	}
+
+	if po.maxSupply.OverZero() {
+		m["max_supply"] = po.maxSupply.String()
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CurrencyPolicyBSONUnmarshaler struct {
 	Hint          string   `bson:"_hint"`
 	NewAccountMin string   `bson:"new_account_min_balance"`
 	Feeer         bson.Raw `bson:"feeer"`
+	MaxMemoSize   uint     `bson:"max_memo_size"`
+	MaxSupply     string   `bson:"max_supply"`
 }
 
 func (po *CurrencyPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -37,5 +44,5 @@ func (po *CurrencyPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, ht, upo.NewAccountMin, upo.Feeer)
+	return po.unpack(enc, ht, upo.NewAccountMin, upo.Feeer, upo.MaxMemoSize, upo.MaxSupply)
 }
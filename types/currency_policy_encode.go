@@ -7,7 +7,7 @@ import (
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-func (po *CurrencyPolicy) unpack(enc encoder.Encoder, ht hint.Hint, mn string, bfe []byte) error {
+func (po *CurrencyPolicy) unpack(enc encoder.Encoder, ht hint.Hint, mn string, bfe []byte, mms uint, ms string) error {
 	e := util.StringError("unmarshal CurrencyPolicy")
 
 	if big, err := common.NewBigFromString(mn); err != nil {
@@ -23,6 +23,15 @@ func (po *CurrencyPolicy) unpack(enc encoder.Encoder, ht hint.Hint, mn string, b
 		return e.WithMessage(err, "failed to decode feeer")
 	}
 	po.feeer = feeer
+	po.maxMemoSize = mms
+
+	if len(ms) > 0 {
+		big, err := common.NewBigFromString(ms)
+		if err != nil {
+			return e.WithMessage(err, "failed to decode max supply")
+		}
+		po.maxSupply = big
+	}
 
 	return nil
 }
@@ -11,13 +11,22 @@ type CurrencyPolicyJSONMarshaler struct {
 	hint.BaseHinter
 	NewAccountMin string `json:"new_account_min_balance"`
 	Feeer         Feeer  `json:"feeer"`
+	MaxMemoSize   uint   `json:"max_memo_size,omitempty"`
+	MaxSupply     string `json:"max_supply,omitempty"`
 }
 
 func (po CurrencyPolicy) MarshalJSON() ([]byte, error) {
+	var ms string
+	if po.maxSupply.OverZero() {
+		ms = po.maxSupply.String()
+	}
+
 	return util.MarshalJSON(CurrencyPolicyJSONMarshaler{
 		BaseHinter:    po.BaseHinter,
 		NewAccountMin: po.newAccountMinBalance.String(),
 		Feeer:         po.feeer,
+		MaxMemoSize:   po.maxMemoSize,
+		MaxSupply:     ms,
 	})
 }
 
@@ -25,6 +34,8 @@ type CurrencyPolicyJSONUnmarshaler struct {
 	Hint          hint.Hint       `json:"_hint"`
 	NewAccountMin string          `json:"new_account_min_balance"`
 	Feeer         json.RawMessage `json:"feeer"`
+	MaxMemoSize   uint            `json:"max_memo_size"`
+	MaxSupply     string          `json:"max_supply"`
 }
 
 func (po *CurrencyPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -35,5 +46,5 @@ func (po *CurrencyPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, upo.Hint, upo.NewAccountMin, upo.Feeer)
+	return po.unpack(enc, upo.Hint, upo.NewAccountMin, upo.Feeer, upo.MaxMemoSize, upo.MaxSupply)
 }
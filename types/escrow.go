@@ -0,0 +1,64 @@
+package types
+
+import (
+	"crypto/sha256"
+	"regexp"
+
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var (
+	MinLengthEscrowID  = 3
+	MaxLengthEscrowID  = 64
+	ReValidEscrowID    = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_\-\.]*[a-zA-Z0-9]$`)
+	EscrowHashlockSize = sha256.Size
+	MaxEscrowPreimage  = 256
+)
+
+// EscrowID identifies escrow; it is chosen by the creator of escrow and can not
+// be reused.
+type EscrowID string
+
+func (id EscrowID) Bytes() []byte {
+	return []byte(id)
+}
+
+func (id EscrowID) String() string {
+	return string(id)
+}
+
+func (id EscrowID) IsValid([]byte) error {
+	if l := len(id); l < MinLengthEscrowID || l > MaxLengthEscrowID {
+		return util.ErrInvalid.Errorf(
+			"invalid length of escrow id, %d <= %d <= %d", MinLengthEscrowID, l, MaxLengthEscrowID)
+	} else if !ReValidEscrowID.Match([]byte(id)) {
+		return util.ErrInvalid.Errorf("wrong escrow id, %v", id)
+	}
+
+	return nil
+}
+
+// EscrowHashlock returns the sha256 hash of preimage. The same hash function is
+// widely supported by the other ledgers, so the hashlock can be shared for
+// atomic swap.
+func EscrowHashlock(preimage []byte) []byte {
+	h := sha256.Sum256(preimage)
+
+	return h[:]
+}
+
+func IsValidEscrowHashlock(hashlock []byte) error {
+	if len(hashlock) != EscrowHashlockSize {
+		return util.ErrInvalid.Errorf("invalid length of hashlock, %d != %d", len(hashlock), EscrowHashlockSize)
+	}
+
+	return nil
+}
+
+func IsValidEscrowPreimage(preimage []byte) error {
+	if l := len(preimage); l < 1 || l > MaxEscrowPreimage {
+		return util.ErrInvalid.Errorf("invalid length of preimage, 0 < %d <= %d", l, MaxEscrowPreimage)
+	}
+
+	return nil
+}
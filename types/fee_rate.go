@@ -0,0 +1,109 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// FeeRate is the rate of fee currency per currency, numerator/denominator.
+// The zero rate disables the fee currency.
+type FeeRate struct {
+	numerator   common.Big
+	denominator common.Big
+}
+
+func NewFeeRate(numerator, denominator common.Big) FeeRate {
+	return FeeRate{numerator: numerator, denominator: denominator}
+}
+
+// ParseFeeRate parses the rate of "<numerator>/<denominator>" or the decimal,
+// like "0.25".
+func ParseFeeRate(s string) (FeeRate, error) {
+	e := util.StringError("parse fee rate")
+
+	if i := strings.Index(s, "/"); i >= 0 {
+		n, err := common.NewBigFromString(s[:i])
+		if err != nil {
+			return FeeRate{}, e.Wrap(err)
+		}
+
+		d, err := common.NewBigFromString(s[i+1:])
+		if err != nil {
+			return FeeRate{}, e.Wrap(err)
+		}
+
+		return NewFeeRate(n, d), nil
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return FeeRate{}, e.Errorf("invalid fee rate, %q", s)
+	}
+
+	return NewFeeRate(common.NewBigFromBigInt(r.Num()), common.NewBigFromBigInt(r.Denom())), nil
+}
+
+func (r FeeRate) Bytes() []byte {
+	return util.ConcatBytesSlice(r.numerator.Bytes(), r.denominator.Bytes())
+}
+
+func (r FeeRate) IsValid([]byte) error {
+	if r.numerator.Int == nil || !r.numerator.OverNil() {
+		return util.ErrInvalid.Errorf("fee rate under zero, %v", r)
+	}
+
+	if r.denominator.Int == nil || !r.denominator.OverZero() {
+		return util.ErrInvalid.Errorf("denominator of fee rate should be over zero, %v", r)
+	}
+
+	return nil
+}
+
+func (r FeeRate) String() string {
+	return r.numerator.String() + "/" + r.denominator.String()
+}
+
+func (r FeeRate) Numerator() common.Big {
+	return r.numerator
+}
+
+func (r FeeRate) Denominator() common.Big {
+	return r.denominator
+}
+
+// IsZero returns true when the fee currency is disabled.
+func (r FeeRate) IsZero() bool {
+	return r.numerator.Int == nil || !r.numerator.OverZero()
+}
+
+// Convert returns fee in fee currency; it is rounded up, so the fee over zero
+// is not converted to zero.
+func (r FeeRate) Convert(fee common.Big) common.Big {
+	n := new(big.Int).Mul(fee.Int, r.numerator.Int)
+
+	q, m := new(big.Int).QuoRem(n, r.denominator.Int, new(big.Int))
+	if m.Sign() > 0 {
+		q = q.Add(q, big.NewInt(1))
+	}
+
+	return common.NewBigFromBigInt(q)
+}
+
+func (r FeeRate) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *FeeRate) UnmarshalText(b []byte) error {
+	i, err := ParseFeeRate(string(b))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	*r = i
+
+	return nil
+}
@@ -10,15 +10,17 @@ import (
 )
 
 const (
-	FeeerNil   = "nil"
-	FeeerFixed = "fixed"
-	FeeerRatio = "ratio"
+	FeeerNil    = "nil"
+	FeeerFixed  = "fixed"
+	FeeerRatio  = "ratio"
+	FeeerTiered = "tiered"
 )
 
 var (
-	NilFeeerHint   = hint.MustNewHint("mitum-currency-nil-feeer-v0.0.1")
-	FixedFeeerHint = hint.MustNewHint("mitum-currency-fixed-feeer-v0.0.1")
-	RatioFeeerHint = hint.MustNewHint("mitum-currency-ratio-feeer-v0.0.1")
+	NilFeeerHint    = hint.MustNewHint("mitum-currency-nil-feeer-v0.0.1")
+	FixedFeeerHint  = hint.MustNewHint("mitum-currency-fixed-feeer-v0.0.1")
+	RatioFeeerHint  = hint.MustNewHint("mitum-currency-ratio-feeer-v0.0.1")
+	TieredFeeerHint = hint.MustNewHint("mitum-currency-tiered-feeer-v0.0.1")
 )
 
 var UnlimitedMaxFeeAmount = common.NewBig(-1)
@@ -216,3 +218,179 @@ func (fa RatioFeeer) isZero() bool {
 func (fa RatioFeeer) isOne() bool {
 	return fa.ratio == 1
 }
+
+var MaxFeeTiers = 10
+
+// FeeTier is the fee bracket of TieredFeeer; it is applied to the amount,
+// which is equal or over from. The fee is fixed + amount * ratio, but not over
+// max.
+type FeeTier struct {
+	from  common.Big
+	fixed common.Big
+	ratio float64 // 0 >=, or <= 1.0
+	max   common.Big
+}
+
+func NewFeeTier(from, fixed common.Big, ratio float64, max common.Big) FeeTier {
+	return FeeTier{
+		from:  from,
+		fixed: fixed,
+		ratio: ratio,
+		max:   max,
+	}
+}
+
+func (ft FeeTier) Bytes() []byte {
+	var rb bytes.Buffer
+	_ = binary.Write(&rb, binary.BigEndian, ft.ratio)
+
+	return util.ConcatBytesSlice(ft.from.Bytes(), ft.fixed.Bytes(), rb.Bytes(), ft.max.Bytes())
+}
+
+func (ft FeeTier) IsValid([]byte) error {
+	if !ft.from.OverNil() {
+		return util.ErrInvalid.Errorf("fee tier from under zero")
+	}
+
+	if !ft.fixed.OverNil() {
+		return util.ErrInvalid.Errorf("fee tier fixed amount under zero")
+	}
+
+	if ft.ratio < 0 || ft.ratio > 1 {
+		return util.ErrInvalid.Errorf("invalid fee tier ratio, %v; it should be 0 >=, <= 1", ft.ratio)
+	}
+
+	if !ft.max.Equal(UnlimitedMaxFeeAmount) {
+		if !ft.max.OverNil() {
+			return util.ErrInvalid.Errorf("fee tier max amount under zero")
+		} else if ft.fixed.Compare(ft.max) > 0 {
+			return util.ErrInvalid.Errorf("fee tier fixed amount over max")
+		}
+	}
+
+	return nil
+}
+
+func (ft FeeTier) From() common.Big {
+	return ft.from
+}
+
+func (ft FeeTier) Fixed() common.Big {
+	return ft.fixed
+}
+
+func (ft FeeTier) Ratio() float64 {
+	return ft.ratio
+}
+
+func (ft FeeTier) Max() common.Big {
+	return ft.max
+}
+
+func (ft FeeTier) Fee(a common.Big) common.Big {
+	f := ft.fixed
+	if ft.ratio != 0 && !a.IsZero() {
+		f = f.Add(a.MulFloat64(ft.ratio))
+	}
+
+	if !ft.max.Equal(UnlimitedMaxFeeAmount) && f.Compare(ft.max) > 0 {
+		return ft.max
+	}
+
+	return f
+}
+
+type TieredFeeer struct {
+	hint.BaseHinter
+	receiver base.Address
+	tiers    []FeeTier
+}
+
+func NewTieredFeeer(receiver base.Address, tiers []FeeTier) TieredFeeer {
+	return TieredFeeer{
+		BaseHinter: hint.NewBaseHinter(TieredFeeerHint),
+		receiver:   receiver,
+		tiers:      tiers,
+	}
+}
+
+func (TieredFeeer) Type() string {
+	return FeeerTiered
+}
+
+func (fa TieredFeeer) Bytes() []byte {
+	bs := make([][]byte, len(fa.tiers)+1)
+	bs[0] = fa.receiver.Bytes()
+
+	for i := range fa.tiers {
+		bs[i+1] = fa.tiers[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fa TieredFeeer) Receiver() base.Address {
+	return fa.receiver
+}
+
+func (fa TieredFeeer) Tiers() []FeeTier {
+	return fa.tiers
+}
+
+func (fa TieredFeeer) Min() common.Big {
+	if len(fa.tiers) < 1 {
+		return common.ZeroBig
+	}
+
+	return fa.tiers[0].Fee(common.ZeroBig)
+}
+
+func (fa TieredFeeer) Fee(a common.Big) (common.Big, error) {
+	if len(fa.tiers) < 1 {
+		return common.ZeroBig, nil
+	}
+
+	tier := fa.tiers[0]
+	for i := range fa.tiers[1:] {
+		if a.Compare(fa.tiers[i+1].from) < 0 {
+			break
+		}
+
+		tier = fa.tiers[i+1]
+	}
+
+	return tier.Fee(a), nil
+}
+
+func (fa TieredFeeer) IsValid([]byte) error {
+	if err := fa.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fa.receiver); err != nil {
+		return util.ErrInvalid.Errorf("invalid receiver for tiered feeer: %v", err)
+	}
+
+	switch n := len(fa.tiers); {
+	case n < 1:
+		return util.ErrInvalid.Errorf("empty tiers for tiered feeer")
+	case n > MaxFeeTiers:
+		return util.ErrInvalid.Errorf("tiers over allowed; %d > %d", n, MaxFeeTiers)
+	}
+
+	for i := range fa.tiers {
+		tier := fa.tiers[i]
+		if err := tier.IsValid(nil); err != nil {
+			return err
+		}
+
+		switch {
+		case i == 0 && !tier.from.IsZero():
+			return util.ErrInvalid.Errorf("first fee tier should be from zero")
+		case i > 0 && tier.from.Compare(fa.tiers[i-1].from) <= 0:
+			return util.ErrInvalid.Errorf("fee tiers should be sorted by from; %v <= %v", tier.from, fa.tiers[i-1].from)
+		}
+	}
+
+	return nil
+}
@@ -97,3 +97,61 @@ func (fa *RatioFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return fa.unpack(enc, ht, ufa.Receiver, ufa.Ratio, ufa.Min, ufa.Max)
 }
+
+func (ft FeeTier) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"from":  ft.from.String(),
+			"fixed": ft.fixed.String(),
+			"ratio": ft.ratio,
+			"max":   ft.max.String(),
+		},
+	)
+}
+
+type FeeTierBSONUnmarshaler struct {
+	From  string  `bson:"from"`
+	Fixed string  `bson:"fixed"`
+	Ratio float64 `bson:"ratio"`
+	Max   string  `bson:"max"`
+}
+
+func (fa TieredFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    fa.Hint().String(),
+			"receiver": fa.receiver,
+			"tiers":    fa.tiers,
+		},
+	)
+}
+
+type TieredFeeerBSONUnmarshaler struct {
+	Hint     string                   `bson:"_hint"`
+	Receiver string                   `bson:"receiver"`
+	Tiers    []FeeTierBSONUnmarshaler `bson:"tiers"`
+}
+
+func (fa *TieredFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of TieredFeeer")
+
+	var ufa TieredFeeerBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ufa.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	tiers := make([]FeeTier, len(ufa.Tiers))
+	for i := range ufa.Tiers {
+		t := ufa.Tiers[i]
+		if err := tiers[i].unpack(t.From, t.Fixed, t.Ratio, t.Max); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return fa.unpack(enc, ht, ufa.Receiver, tiers)
+}
@@ -61,3 +61,45 @@ func (fa *RatioFeeer) unpack(
 
 	return nil
 }
+
+func (ft *FeeTier) unpack(from, fixed string, ratio float64, max string) error {
+	e := util.StringError("unmarshal FeeTier")
+
+	if big, err := common.NewBigFromString(from); err != nil {
+		return e.Wrap(err)
+	} else {
+		ft.from = big
+	}
+
+	if big, err := common.NewBigFromString(fixed); err != nil {
+		return e.Wrap(err)
+	} else {
+		ft.fixed = big
+	}
+
+	ft.ratio = ratio
+
+	if big, err := common.NewBigFromString(max); err != nil {
+		return e.Wrap(err)
+	} else {
+		ft.max = big
+	}
+
+	return nil
+}
+
+func (fa *TieredFeeer) unpack(enc encoder.Encoder, ht hint.Hint, rc string, tiers []FeeTier) error {
+	e := util.StringError("unmarshal TieredFeeer")
+
+	switch ad, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fa.receiver = ad
+	}
+
+	fa.tiers = tiers
+	fa.BaseHinter = hint.NewBaseHinter(ht)
+
+	return nil
+}
@@ -93,3 +93,65 @@ func (fa *RatioFeeer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	return fa.unpack(enc, ufa.Hint, ufa.Receiver, ufa.Ratio, ufa.Min, ufa.Max)
 }
+
+type FeeTierJSONMarshaler struct {
+	From  string  `json:"from"`
+	Fixed string  `json:"fixed"`
+	Ratio float64 `json:"ratio"`
+	Max   string  `json:"max"`
+}
+
+func (ft FeeTier) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeTierJSONMarshaler{
+		From:  ft.from.String(),
+		Fixed: ft.fixed.String(),
+		Ratio: ft.ratio,
+		Max:   ft.max.String(),
+	})
+}
+
+type FeeTierJSONUnmarshaler struct {
+	From  string  `json:"from"`
+	Fixed string  `json:"fixed"`
+	Ratio float64 `json:"ratio"`
+	Max   string  `json:"max"`
+}
+
+type TieredFeeerJSONMarshaler struct {
+	hint.BaseHinter
+	Receiver base.Address `json:"receiver"`
+	Tiers    []FeeTier    `json:"tiers"`
+}
+
+func (fa TieredFeeer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TieredFeeerJSONMarshaler{
+		BaseHinter: fa.BaseHinter,
+		Receiver:   fa.receiver,
+		Tiers:      fa.tiers,
+	})
+}
+
+type TieredFeeerJSONUnmarshaler struct {
+	Hint     hint.Hint                `json:"_hint"`
+	Receiver string                   `json:"receiver"`
+	Tiers    []FeeTierJSONUnmarshaler `json:"tiers"`
+}
+
+func (fa *TieredFeeer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("decode json of TieredFeeer")
+
+	var ufa TieredFeeerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e.Wrap(err)
+	}
+
+	tiers := make([]FeeTier, len(ufa.Tiers))
+	for i := range ufa.Tiers {
+		t := ufa.Tiers[i]
+		if err := tiers[i].unpack(t.From, t.Fixed, t.Ratio, t.Max); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return fa.unpack(enc, ufa.Hint, ufa.Receiver, tiers)
+}
@@ -1,21 +1,28 @@
 package types
 
-// import "github.com/ProtoconNet/mitum2/util"
+import (
+	"unicode/utf8"
 
-// var MaxMemoSize = 100 // TODO should be managed by policy
+	"github.com/ProtoconNet/mitum2/util"
+)
 
-// func IsValidMemo(s string) error {
-// 	if len(s) > MaxMemoSize {
-// 		return util.ErrInvalid.Errorf("memo over max size, %d > %d", len(s), MaxMemoSize)
-// 	}
+var (
+	// MaxMemoSize is the default maximum memo size of currency, which does
+	// not set it's own in CurrencyPolicy.
+	MaxMemoSize uint = 100
+	// MemoSizeLimit is the upper bound of memo size; CurrencyPolicy can not
+	// allow longer memo than this.
+	MemoSizeLimit uint = 1024
+)
 
-// 	return nil
-// }
+func IsValidMemo(s string) error {
+	if n := uint(len(s)); n > MemoSizeLimit {
+		return util.ErrInvalid.Errorf("memo over max size, %d > %d", n, MemoSizeLimit)
+	}
 
-// type MemoBSONUnmarshaler struct {
-// 	Memo string `bson:"memo"`
-// }
+	if !utf8.ValidString(s) {
+		return util.ErrInvalid.Errorf("memo is not valid utf-8 string")
+	}
 
-// type MemoJSONUnMarshaler struct {
-// 	Memo string `json:"memo"`
-// }
+	return nil
+}
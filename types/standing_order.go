@@ -0,0 +1,60 @@
+package types
+
+import (
+	"regexp"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var (
+	MinLengthStandingOrderID = 3
+	MaxLengthStandingOrderID = 64
+	ReValidStandingOrderID   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_\-\.]*[a-zA-Z0-9]$`)
+	MaxStandingOrderCount    = uint64(10000)
+	// MaxStandingOrderPaymentsPerHeight limits the standing orders paid at a
+	// height; the rest are paid at the next heights.
+	MaxStandingOrderPaymentsPerHeight = 1000
+	// MaxStandingOrderScanHeights limits the due heights scanned at a height;
+	// the rest are scanned at the next heights.
+	MaxStandingOrderScanHeights = 100
+)
+
+// StandingOrderID identifies the standing order among the orders of the same
+// sender.
+type StandingOrderID string
+
+func (id StandingOrderID) Bytes() []byte {
+	return []byte(id)
+}
+
+func (id StandingOrderID) String() string {
+	return string(id)
+}
+
+func (id StandingOrderID) IsValid([]byte) error {
+	if l := len(id); l < MinLengthStandingOrderID || l > MaxLengthStandingOrderID {
+		return util.ErrInvalid.Errorf(
+			"invalid length of standing order id, %d <= %d <= %d",
+			MinLengthStandingOrderID, l, MaxLengthStandingOrderID)
+	} else if !ReValidStandingOrderID.Match([]byte(id)) {
+		return util.ErrInvalid.Errorf("wrong standing order id, %v", id)
+	}
+
+	return nil
+}
+
+// IsValidStandingOrderSchedule checks the schedule of standing order; the
+// payments are due at start, start+interval, ... for count times.
+func IsValidStandingOrderSchedule(start base.Height, interval, count uint64) error {
+	switch {
+	case start <= base.GenesisHeight:
+		return util.ErrInvalid.Errorf("invalid start height, %v", start)
+	case interval < 1:
+		return util.ErrInvalid.Errorf("interval should be over zero")
+	case count < 1 || count > MaxStandingOrderCount:
+		return util.ErrInvalid.Errorf("invalid count, 0 < %d <= %d", count, MaxStandingOrderCount)
+	}
+
+	return nil
+}
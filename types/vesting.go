@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+// IsValidVestingSchedule checks the heights of vesting schedule; the amount is
+// released linearly from start to end, and nothing can be claimed before
+// cliff.
+func IsValidVestingSchedule(start, cliff, end base.Height) error {
+	switch {
+	case start < base.GenesisHeight:
+		return util.ErrInvalid.Errorf("invalid start height, %v", start)
+	case cliff < start:
+		return util.ErrInvalid.Errorf("cliff height under start height, %v < %v", cliff, start)
+	case end <= start:
+		return util.ErrInvalid.Errorf("end height should be over start height, %v <= %v", end, start)
+	case end < cliff:
+		return util.ErrInvalid.Errorf("end height under cliff height, %v < %v", end, cliff)
+	}
+
+	return nil
+}